@@ -0,0 +1,94 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+// Calendar describes which days are business days: those whose weekday is one of the
+// Workdays and that are not one of the Holidays.
+//
+// The zero Calendar has no holidays and treats Monday to Friday as workdays.
+type Calendar struct {
+	// Workdays is the set of weekdays on which business is done; if empty, Weekdays is used.
+	Workdays WeekdaySet
+	// Holidays is the set of dates on which business is not done; it may be nil.
+	Holidays Set
+}
+
+// IsBusinessDay tests whether d is a business day in the calendar.
+func (c Calendar) IsBusinessDay(d Date) bool {
+	workdays := c.Workdays
+	if workdays == 0 {
+		workdays = Weekdays
+	}
+	return d.InSet(workdays) && !c.Holidays.Contains(d)
+}
+
+// BusinessDaysBetween counts the business days from one date up to, but not including,
+// another date. The count is signed: if to is before from, the result is the negation of
+// BusinessDaysBetween(to, from), i.e. minus the number of business days from to up to,
+// but not including, from. So the business days from 10th January 2024 to 1st January
+// 2024 are -7.
+func (c Calendar) BusinessDaysBetween(from, to Date) int {
+	sign := 1
+	if to < from {
+		from, to = to, from
+		sign = -1
+	}
+	n := 0
+	for d := from; d < to; d++ {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// maxPrefixSpan limits the number of days counted in advance by BusinessDaysBetweenMany,
+// which is about 2,900 years.
+const maxPrefixSpan Date = 1 << 20
+
+// BusinessDaysBetweenMany is equivalent to calling BusinessDaysBetween for each pair
+// of dates, giving one result per pair. As with BusinessDaysBetween, a pair whose second
+// date is before its first gives a negative count. It is much faster when there are many pairs,
+// because the business days are counted only once across the whole span of dates
+// from the earliest to the latest in any pair; after that, each pair is answered in
+// constant time.
+//
+// If the span is very long, or longer than all the pairs put together, the pairs are
+// counted individually instead, so that the memory used stays bounded.
+func (c Calendar) BusinessDaysBetweenMany(pairs [][2]Date) []int {
+	result := make([]int, len(pairs))
+	if len(pairs) == 0 {
+		return result
+	}
+
+	lo, hi := pairs[0][0], pairs[0][0]
+	var total Date
+	for _, p := range pairs {
+		lo = min(lo, p[0], p[1])
+		hi = max(hi, p[0], p[1])
+		total = min(total+max(p[1]-p[0], p[0]-p[1]), maxPrefixSpan)
+	}
+
+	if hi-lo >= maxPrefixSpan || hi-lo > total {
+		for i, p := range pairs {
+			result[i] = c.BusinessDaysBetween(p[0], p[1])
+		}
+		return result
+	}
+
+	// prefix[i] is the number of business days from lo up to, but not including, lo+i
+	prefix := make([]int, int(hi-lo)+1)
+	for i := 1; i < len(prefix); i++ {
+		prefix[i] = prefix[i-1]
+		if c.IsBusinessDay(lo + Date(i-1)) {
+			prefix[i]++
+		}
+	}
+
+	for i, p := range pairs {
+		result[i] = prefix[p[1]-lo] - prefix[p[0]-lo]
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestCalendar_BusinessDaysBetween(t *testing.T) {
+	christmas := NewSet(New(2023, time.December, 25), New(2023, time.December, 26))
+	cases := []struct {
+		cal      Calendar
+		from, to Date
+		expected int
+	}{
+		{cal: Calendar{}, from: New(2023, time.December, 18), to: New(2023, time.December, 18), expected: 0},
+		{cal: Calendar{}, from: New(2023, time.December, 18), to: New(2023, time.December, 25), expected: 5},
+		{cal: Calendar{}, from: New(2023, time.December, 25), to: New(2023, time.December, 18), expected: -5},
+		{cal: Calendar{Holidays: christmas}, from: New(2023, time.December, 18), to: New(2024, time.January, 1), expected: 8},
+		{cal: Calendar{Workdays: NewWeekdaySet(time.Sunday)}, from: New(2023, time.December, 1), to: New(2024, time.January, 1), expected: 5},
+		{cal: Calendar{}, from: New(-1, time.January, 1), to: New(1, time.January, 1), expected: 521},
+	}
+	for i, c := range cases {
+		n := c.cal.BusinessDaysBetween(c.from, c.to)
+		if n != c.expected {
+			t.Errorf("%d: BusinessDaysBetween(%v, %v) == %d, want %d", i, c.from, c.to, n, c.expected)
+		}
+	}
+}
+
+func TestCalendar_BusinessDaysBetweenMany(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	base := New(2020, time.January, 1)
+
+	holidays := NewSet()
+	for i := 0; i < 50; i++ {
+		holidays.Add(base + Date(rnd.Intn(2000)))
+	}
+	cal := Calendar{Workdays: Weekdays.Add(time.Saturday), Holidays: holidays}
+
+	pairs := make([][2]Date, 0, 1000)
+	for i := 0; i < 500; i++ {
+		p := [2]Date{base + Date(rnd.Intn(2000)-100), base + Date(rnd.Intn(2000)-100)}
+		// each pair in both orders
+		pairs = append(pairs, p, [2]Date{p[1], p[0]})
+	}
+
+	got := cal.BusinessDaysBetweenMany(pairs)
+	if len(got) != len(pairs) {
+		t.Fatalf("got %d results for %d pairs", len(got), len(pairs))
+	}
+	for i, p := range pairs {
+		want := cal.BusinessDaysBetween(p[0], p[1])
+		if got[i] != want {
+			t.Errorf("%d: %v to %v gave %d, want %d", i, p[0], p[1], got[i], want)
+		}
+		if i%2 == 1 && got[i] != -got[i-1] {
+			t.Errorf("%d: %v to %v gave %d, want %d", i, p[0], p[1], got[i], -got[i-1])
+		}
+	}
+
+	from, to := New(2024, time.January, 10), New(2024, time.January, 1)
+	if n := (Calendar{}).BusinessDaysBetweenMany([][2]Date{{from, to}}); n[0] != -7 {
+		t.Errorf("%v to %v gave %d, want -7", from, to, n[0])
+	}
+
+	if len(cal.BusinessDaysBetweenMany(nil)) != 0 {
+		t.Errorf("expected no results")
+	}
+
+	// short pairs at the extremes of the range are counted individually
+	pairs = [][2]Date{{MinDate, MinDate + 30}, {MaxDate - 30, MaxDate}, {base, base + 7}}
+	got = cal.BusinessDaysBetweenMany(pairs)
+	for i, p := range pairs {
+		if want := cal.BusinessDaysBetween(p[0], p[1]); got[i] != want {
+			t.Errorf("%d: %v to %v gave %d, want %d", i, p[0], p[1], got[i], want)
+		}
+	}
+}
+
+func BenchmarkCalendar_BusinessDaysBetweenMany(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	base := New(2020, time.January, 1)
+	pairs := make([][2]Date, 1000)
+	for i := range pairs {
+		pairs[i] = [2]Date{base + Date(rnd.Intn(3650)), base + Date(rnd.Intn(3650))}
+	}
+	cal := Calendar{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cal.BusinessDaysBetweenMany(pairs)
+	}
+}
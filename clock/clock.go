@@ -29,6 +29,9 @@ type Clock int64
 
 // Common durations - second, minute, hour and day.
 const (
+	// Microsecond is one microsecond; it has a similar meaning to time.Microsecond.
+	Microsecond Clock = Clock(time.Microsecond)
+
 	// Millisecond is one millisecond; it has a similar meaning to time.Millisecond.
 	Millisecond Clock = Clock(time.Millisecond)
 
@@ -46,10 +49,10 @@ const (
 	Day Clock = Clock(time.Hour * 24)
 )
 
-// Midnight is the zero value of a Clock.
+// Midnight is the zero value of a Clock. This is 12am, the start of the day.
 const Midnight Clock = 0
 
-// Noon is at 12pm.
+// Noon is at 12pm, the middle of the day.
 const Noon Clock = Hour * 12
 
 // Undefined is provided because the zero value of a Clock *is* defined (i.e. Midnight).
@@ -88,6 +91,21 @@ func (c Clock) DurationSinceMidnight() time.Duration {
 	return time.Duration(c)
 }
 
+// ClockFromDuration returns a new Clock based on a duration since some arbitrary midnight.
+// Unlike SinceMidnight, the duration is taken modulo 24 hours (see Mod24), so negative
+// durations wrap to the previous evening and durations of 24 hours or more wrap to the
+// following day(s). For example, -90 minutes gives 22:30 and 25 hours gives 01:00.
+func ClockFromDuration(d time.Duration) Clock {
+	return Clock(d).Mod24()
+}
+
+// Duration converts a clock to a time.Duration since midnight. Unlike DurationSinceMidnight,
+// the clock value is first taken modulo 24 hours (see Mod24), so the result is always in the
+// range [0, 24h). Note that this means 24:00:00 gives zero.
+func (c Clock) Duration() time.Duration {
+	return time.Duration(c.Mod24())
+}
+
 // Add returns a new Clock offset from this clock specified hour, minute, second and millisecond.
 // The parameters can be negative.
 //
@@ -108,6 +126,24 @@ func (c Clock) AddDuration(d time.Duration) Clock {
 	return c + Clock(d)
 }
 
+// AddDays returns a new Clock offset from this clock by a duration, wrapped to within
+// one day as per Mod24, along with the signed number of midnights crossed. For example,
+// adding 26 hours to 10:00 gives 12:00 and 1, and subtracting an hour from 00:30 gives
+// 23:30 and -1. This is useful when combining a clock with a date, which must be
+// adjusted by the number of days.
+//
+// As with Mod24, every day is assumed to have 24 hours. The clock and the days together
+// always account for the whole result, so a result of exactly -24 hours gives midnight
+// and -1 (whereas Days would give -2).
+func (c Clock) AddDays(d time.Duration) (Clock, int) {
+	r := c.AddDuration(d)
+	days := r / Day
+	if r%Day < 0 {
+		days--
+	}
+	return r - days*Day, int(days)
+}
+
 // AddPeriod returns a new Clock offset from this clock by a time period.
 // The parameter can be negative.
 //
@@ -142,14 +178,36 @@ func (c Clock) IsInOneDay() bool {
 }
 
 // IsMidnight tests whether a clock time is midnight. This is shorthand for c.Mod24() == 0.
+// Both 00:00:00 (the start of a day) and 24:00:00 (the end of a day) are treated as
+// midnight, as are any other whole multiples of 24 hours.
 // For large values, this assumes that every day has 24 hours.
 func (c Clock) IsMidnight() bool {
 	return c.Mod24() == Midnight
 }
 
+// IsNoon tests whether a clock time is noon (12pm). This is shorthand for c.Mod24() == Noon.
+// For large values, this assumes that every day has 24 hours.
+func (c Clock) IsNoon() bool {
+	return c.Mod24() == Noon
+}
+
+// InRange tests whether a clock time is within the range from start to end, inclusive.
+// If start is after end, the range is taken to wrap through midnight; for example, a night
+// shift from 22:00 to 06:00 includes 23:30 and 05:00 but not 12:00. If start equals end,
+// only that time is in range.
+//
+// The values are compared as given, so any that lie outside a single day should be
+// corrected with Mod24 first.
+func (c Clock) InRange(start, end Clock) bool {
+	if start <= end {
+		return start <= c && c <= end
+	}
+	return start <= c || c <= end
+}
+
 // TruncateMillisecond discards any fractional digits within the millisecond represented by c.
 // For example, for 10:20:30.456111222 this will return 10:20:30.456.
-// This method will force the String method to limit its output to three decimal places.
+// This method will force the String method to limit its output to at most three decimal places.
 func (c Clock) TruncateMillisecond() Clock {
 	return (c / Millisecond) * Millisecond
 }
@@ -66,6 +66,40 @@ func TestClockSinceMidnight(t *testing.T) {
 	}
 }
 
+func TestClockFromDuration(t *testing.T) {
+	cases := []struct {
+		d    time.Duration
+		want Clock
+	}{
+		{0, Midnight},
+		{14*time.Hour + 30*time.Minute, New(14, 30, 0, 0)},
+		{24 * time.Hour, Midnight},
+		{25 * time.Hour, New(1, 0, 0, 0)},
+		{49*time.Hour + time.Millisecond, New(1, 0, 0, 1)},
+		{-90 * time.Minute, New(22, 30, 0, 0)},
+		{-24 * time.Hour, Midnight},
+		{-1, New(23, 59, 59, 999).AddDuration(999999)},
+	}
+	for i, x := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, x.d), func(t *testing.T) {
+			c := ClockFromDuration(x.d)
+			if c != x.want {
+				t.Errorf("%d: got %v, want %v", i, c, x.want)
+			}
+			if c.Duration() != time.Duration(x.want) {
+				t.Errorf("%d: got %v, want %v", i, c.Duration(), time.Duration(x.want))
+			}
+		})
+	}
+
+	if Day.Duration() != 0 {
+		t.Errorf("got %v, want 0", Day.Duration())
+	}
+	if New(-1, 0, 0, 0).Duration() != 23*time.Hour {
+		t.Errorf("got %v, want 23h", New(-1, 0, 0, 0).Duration())
+	}
+}
+
 func TestClockIsInOneDay(t *testing.T) {
 	cases := []struct {
 		in   Clock
@@ -145,6 +179,34 @@ func TestClockAddDuration(t *testing.T) {
 	}
 }
 
+func TestClockAddDays(t *testing.T) {
+	cases := []struct {
+		in   Clock
+		d    time.Duration
+		want Clock
+		days int
+	}{
+		{in: Midnight, d: 26 * time.Hour, want: New(2, 0, 0, 0), days: 1},
+		{in: New(10, 0, 0, 0), d: 26 * time.Hour, want: Noon, days: 1},
+		{in: New(0, 30, 0, 0), d: -time.Hour, want: New(23, 30, 0, 0), days: -1},
+		{in: New(23, 0, 0, 0), d: time.Hour, want: Midnight, days: 1},
+		{in: Midnight, d: -time.Nanosecond, want: Day - 1, days: -1},
+		{in: Noon, d: 0, want: Noon, days: 0},
+		{in: Noon, d: 11 * time.Hour, want: New(23, 0, 0, 0), days: 0},
+		{in: Noon, d: -12 * time.Hour, want: Midnight, days: 0},
+		{in: Noon, d: -36 * time.Hour, want: Midnight, days: -1},
+		{in: Noon, d: -60 * time.Hour, want: Midnight, days: -2},
+		{in: Noon, d: -61 * time.Hour, want: New(23, 0, 0, 0), days: -3},
+		{in: Noon, d: 72 * time.Hour, want: Noon, days: 3},
+	}
+	for i, x := range cases {
+		got, days := x.in.AddDays(x.d)
+		if got != x.want || days != x.days {
+			t.Errorf("%d: %v.AddDays(%v) got %v, %d; want %v, %d", i, x.in, x.d, got, days, x.want, x.days)
+		}
+	}
+}
+
 func TestClockAddPeriod(t *testing.T) {
 	cases := []struct {
 		p        period.Period
@@ -198,6 +260,10 @@ func TestClockIsMidnight(t *testing.T) {
 		want bool
 	}{
 		{New(0, 0, 0, 0), true},
+		{Midnight, true},
+		{MustParse("00:00:00"), true},
+		{MustParse("24:00:00"), true},
+		{MustParse("12am"), true},
 		{Day, true},
 		{24 * Hour, true},
 		{New(24, 0, 0, 0), true},
@@ -208,6 +274,7 @@ func TestClockIsMidnight(t *testing.T) {
 		{New(2, 0, 0, 1), false},
 		{New(-1, 0, 0, 0), false},
 		{New(0, 0, 0, -1), false},
+		{Noon, false},
 	}
 	for i, x := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, x.in), func(t *testing.T) {
@@ -219,6 +286,71 @@ func TestClockIsMidnight(t *testing.T) {
 	}
 }
 
+func TestClockInRange(t *testing.T) {
+	day := New(9, 0, 0, 0)
+	dusk := New(17, 0, 0, 0)
+	night := New(22, 0, 0, 0)
+	dawn := New(6, 0, 0, 0)
+
+	cases := []struct {
+		in         Clock
+		start, end Clock
+		want       bool
+	}{
+		// normal range
+		{New(12, 0, 0, 0), day, dusk, true},
+		{day, day, dusk, true},
+		{dusk, day, dusk, true},
+		{day - 1, day, dusk, false},
+		{dusk + 1, day, dusk, false},
+		{New(23, 0, 0, 0), day, dusk, false},
+		// wrapping through midnight
+		{New(23, 30, 0, 0), night, dawn, true},
+		{Midnight, night, dawn, true},
+		{New(5, 0, 0, 0), night, dawn, true},
+		{night, night, dawn, true},
+		{dawn, night, dawn, true},
+		{night - 1, night, dawn, false},
+		{dawn + 1, night, dawn, false},
+		{Noon, night, dawn, false},
+		// single instant
+		{Noon, Noon, Noon, true},
+		{Noon + 1, Noon, Noon, false},
+	}
+	for i, x := range cases {
+		got := x.in.InRange(x.start, x.end)
+		if got != x.want {
+			t.Errorf("%d: %v.InRange(%v, %v) got %v, want %v", i, x.in, x.start, x.end, got, x.want)
+		}
+	}
+}
+
+func TestClockIsNoon(t *testing.T) {
+	cases := []struct {
+		in   Clock
+		want bool
+	}{
+		{Noon, true},
+		{New(12, 0, 0, 0), true},
+		{New(36, 0, 0, 0), true},
+		{New(-12, 0, 0, 0), true},
+		{MustParse("12pm"), true},
+		{MustParse("12am"), false},
+		{Midnight, false},
+		{Day, false},
+		{New(12, 0, 0, 1), false},
+		{New(11, 59, 59, 999), false},
+	}
+	for i, x := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, x.in), func(t *testing.T) {
+			got := x.in.IsNoon()
+			if got != x.want {
+				t.Errorf("%d: %v got %v, want %v, %d", i, x.in, got, x.want, x.in.Mod24())
+			}
+		})
+	}
+}
+
 func TestClockMod24(t *testing.T) {
 	cases := []struct {
 		h, want Clock
@@ -321,36 +453,46 @@ func TestClockFormat(t *testing.T) {
 
 func TestClockString(t *testing.T) {
 	cases := []struct {
-		h, m, s, ms, ns Clock
-		trunc, str      string
+		h, m, s, ms, ns       Clock
+		trunc, str, full, iso string
 	}{
-		{0, 0, 0, 0, 0, "00:00:00.000", "00:00:00.000"},
-		{0, 0, 0, 1, 0, "00:00:00.001", "00:00:00.001"},
-		{0, 0, 0, 0, 1, "00:00:00.000", "00:00:00.000000001"},
-		{0, 0, 1, 0, 0, "00:00:01.000", "00:00:01.000"},
-		{0, 1, 0, 0, 0, "00:01:00.000", "00:01:00.000"},
-		{1, 0, 0, 0, 0, "01:00:00.000", "01:00:00.000"},
-		{1, 2, 3, 4, 5, "01:02:03.004", "01:02:03.004000005"},
-		{11, 0, 0, 0, 0, "11:00:00.000", "11:00:00.000"},
-		{12, 0, 0, 0, 0, "12:00:00.000", "12:00:00.000"},
-		{13, 0, 0, 0, 0, "13:00:00.000", "13:00:00.000"},
-		{24, 0, 0, 0, 0, "24:00:00.000", "24:00:00.000"},
-		{24, 0, 0, 1, 0, "00:00:00.001", "00:00:00.001"},
-		{-1, 0, 0, 0, 0, "23:00:00.000", "23:00:00.000"},
-		{-1, -1, -1, -1, 0, "22:58:58.999", "22:58:58.999"},
+		{0, 0, 0, 0, 0, "00:00", "00:00", "00:00:00.000000000", "00:00:00.000"},
+		{0, 0, 0, 1, 0, "00:00:00.001", "00:00:00.001", "00:00:00.001000000", "00:00:00.001"},
+		{0, 0, 0, 0, 1, "00:00", "00:00:00.000000001", "00:00:00.000000001", "00:00:00.000000001"},
+		{0, 0, 0, 0, 1000, "00:00", "00:00:00.000001", "00:00:00.000001000", "00:00:00.000001000"},
+		{0, 0, 1, 0, 0, "00:00:01", "00:00:01", "00:00:01.000000000", "00:00:01.000"},
+		{0, 1, 0, 0, 0, "00:01", "00:01", "00:01:00.000000000", "00:01:00.000"},
+		{1, 0, 0, 0, 0, "01:00", "01:00", "01:00:00.000000000", "01:00:00.000"},
+		{1, 2, 3, 4, 5, "01:02:03.004", "01:02:03.004000005", "01:02:03.004000005", "01:02:03.004000005"},
+		{11, 0, 0, 0, 0, "11:00", "11:00", "11:00:00.000000000", "11:00:00.000"},
+		{12, 0, 0, 0, 0, "12:00", "12:00", "12:00:00.000000000", "12:00:00.000"},
+		{13, 0, 0, 0, 0, "13:00", "13:00", "13:00:00.000000000", "13:00:00.000"},
+		{14, 30, 0, 0, 0, "14:30", "14:30", "14:30:00.000000000", "14:30:00.000"},
+		{14, 30, 5, 0, 0, "14:30:05", "14:30:05", "14:30:05.000000000", "14:30:05.000"},
+		{14, 30, 5, 250, 0, "14:30:05.250", "14:30:05.250", "14:30:05.250000000", "14:30:05.250"},
+		{24, 0, 0, 0, 0, "24:00", "24:00", "24:00:00.000000000", "24:00:00.000"},
+		{24, 0, 0, 1, 0, "00:00:00.001", "00:00:00.001", "00:00:00.001000000", "00:00:00.001"},
+		{-1, 0, 0, 0, 0, "23:00", "23:00", "23:00:00.000000000", "23:00:00.000"},
+		{-1, -1, -1, -1, 0, "22:58:58.999", "22:58:58.999", "22:58:58.999000000", "22:58:58.999"},
 	}
 	for i, x := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, x.str), func(t *testing.T) {
 			d := x.h*Hour + x.m*Minute + x.s*Second + x.ms*Millisecond + x.ns
 			tr := d.TruncateMillisecond()
 			if tr.String() != x.trunc {
-				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, tr.String(), x.str, d)
+				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, tr.String(), x.trunc, d)
 			}
 			if d.String() != x.str {
 				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, d.String(), x.str, d)
 			}
-			if ValueAsString(d) != x.str {
-				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, d.String(), x.str, d)
+			if d.StringFull() != x.full {
+				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, d.StringFull(), x.full, d)
+			}
+			if ValueAsString(d) != x.iso {
+				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, ValueAsString(d), x.iso, d)
+			}
+			if MustParse(d.String()) != d.Mod24() && d != Day {
+				t.Errorf("%d, %d, %d, %d, got %v, want %v (%d)", x.h, x.m, x.s, x.ns, MustParse(d.String()), d.Mod24(), d)
 			}
 		})
 	}
@@ -410,6 +552,15 @@ func TestClockParseGoods(t *testing.T) {
 		{"1:20:30.04pm", New(13, 20, 30, 40)},
 		{"1:20:30.4pm", New(13, 20, 30, 400)},
 		{"1:20:30.pm", New(13, 20, 30, 0)},
+		// decorations
+		{" 14:30:00 ", New(14, 30, 0, 0)},
+		{"\t14:30\n", New(14, 30, 0, 0)},
+		{"T14:30:00", New(14, 30, 0, 0)},
+		{"T143000", New(14, 30, 0, 0)},
+		{" T14:30:00.250 ", New(14, 30, 0, 250)},
+		{"2:45 pm", New(14, 45, 0, 0)},
+		{" 2:45 pm ", New(14, 45, 0, 0)},
+		{" 2 AM", New(2, 0, 0, 0)},
 	}
 	for i, x := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, x.str), func(t *testing.T) {
@@ -421,6 +572,29 @@ func TestClockParseGoods(t *testing.T) {
 	}
 }
 
+func TestClockParseDecimalSeparators(t *testing.T) {
+	cases := []struct {
+		dot, comma string
+		want       Clock
+	}{
+		{"14:30:05.250", "14:30:05,250", New(14, 30, 5, 250)},
+		{"14:30:05.2", "14:30:05,2", New(14, 30, 5, 200)},
+		{"14:30:05.123456789", "14:30:05,123456789", New(14, 30, 5, 123).AddDuration(456789)},
+		{"2:30:05.25pm", "2:30:05,25pm", New(14, 30, 5, 250)},
+		{"2:30:05.250pm", "2:30:05,250pm", New(14, 30, 5, 250)},
+		{"2:30:05.pm", "2:30:05,pm", New(14, 30, 5, 0)},
+	}
+	for i, x := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, x.comma), func(t *testing.T) {
+			c1 := MustParse(x.dot)
+			c2 := MustParse(x.comma)
+			if c1 != x.want || c2 != x.want {
+				t.Errorf("got %v and %v, want %v", c1, c2, x.want)
+			}
+		})
+	}
+}
+
 func TestClockParseBads(t *testing.T) {
 	cases := []struct {
 		str string
@@ -453,6 +627,15 @@ func TestClockParseBads(t *testing.T) {
 		{"1:02:03-04pm"},
 		{"1:02:03-004pm"},
 		{"1:02:03.0045pm"},
+		{"1:02:03,0045pm"},
+		{"00:00:00,xxx"},
+		{"00:00:00;000"},
+		{"14T30"},
+		{"14:30T00"},
+		{"14:30:00T"},
+		{"TT14:30"},
+		{"T"},
+		{" "},
 	}
 	for i, x := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, x), func(t *testing.T) {
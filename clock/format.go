@@ -67,18 +67,55 @@ func (c Clock) HhMmSs12() string {
 }
 
 // String gets the clock-face number of hours, minutes, seconds and fraction as an ISO-8601 time
-// string.
+// string, using the shortest form that does not lose precision.
 //
 // If the clock value has more than 24 hours, the excess is discarded (see Mod24).
 //
-// The number of decimal places depends on the clock value. If microsecond and nanosecond digits
-// are non-zero, the result is given to nanosecond precision. Otherwise, a shorter form is used that
-// only has millisecond precision.
+// The seconds are omitted if they and any fraction are zero, e.g. "14:30". Otherwise, the
+// fraction is omitted if it is zero, e.g. "14:30:05". Otherwise, the fraction is given to
+// millisecond, microsecond or nanosecond precision, whichever is shortest without losing any
+// non-zero digits, e.g. "14:30:05.250".
 //
-// See TruncateMillisecond to obtain the shorter form always.
+// See StringFull for a fixed-width form better suited to machine output.
 //
-// The special case of midnight at the end of a day is "24:00:00.000".
+// The special case of midnight at the end of a day is "24:00".
 func (c Clock) String() string {
+	if c == Day {
+		return "24:00"
+	}
+	cm := c.Mod24()
+	switch {
+	case cm%Minute == 0:
+		return fmt.Sprintf("%02d:%02d", clockHour(cm), clockMinute(cm))
+	case cm%Second == 0:
+		return fmt.Sprintf("%02d:%02d:%02d", clockHour(cm), clockMinute(cm), clockSecond(cm))
+	case cm%Millisecond == 0:
+		return fmt.Sprintf("%02d:%02d:%02d.%03d", clockHour(cm), clockMinute(cm), clockSecond(cm), clockMillisecond(cm))
+	case cm%Microsecond == 0:
+		return fmt.Sprintf("%02d:%02d:%02d.%06d", clockHour(cm), clockMinute(cm), clockSecond(cm), clockNanosecond(cm)/Microsecond)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d.%09d", clockHour(cm), clockMinute(cm), clockSecond(cm), clockNanosecond(cm))
+}
+
+// StringFull gets the clock-face number of hours, minutes, seconds and nanoseconds as an
+// ISO-8601 time string that always has nine decimal places, e.g. "14:30:05.250000000".
+//
+// If the clock value has more than 24 hours, the excess is discarded (see Mod24).
+//
+// The special case of midnight at the end of a day is "24:00:00.000000000".
+func (c Clock) StringFull() string {
+	if c == Day {
+		return "24:00:00.000000000"
+	}
+	cm := c.Mod24()
+	return fmt.Sprintf("%02d:%02d:%02d.%09d", clockHour(cm), clockMinute(cm), clockSecond(cm), clockNanosecond(cm))
+}
+
+// isoString gets the clock-face number of hours, minutes, seconds and fraction as an ISO-8601
+// time string. If microsecond and nanosecond digits are non-zero, the result is given to
+// nanosecond precision. Otherwise, a shorter form is used that only has millisecond precision.
+// This is used for text marshalling and for storage as strings.
+func (c Clock) isoString() string {
 	if c == Day {
 		return "24:00:00.000"
 	}
@@ -28,8 +28,10 @@ func (c *Clock) UnmarshalBinary(data []byte) error {
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
+// The clock is given to millisecond precision (e.g. "14:30:05.250"), or to
+// nanosecond precision if there are any non-zero sub-millisecond digits.
 func (c Clock) MarshalText() ([]byte, error) {
-	return []byte(c.String()), nil
+	return []byte(c.isoString()), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
@@ -0,0 +1,62 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullClock represents a Clock that may be null, for example in a nullable SQL column or an
+// optional JSON field. It is similar to sql.NullTime.
+type NullClock struct {
+	Clock Clock
+	Valid bool // Valid is true if Clock is not NULL
+}
+
+// Scan implements sql.Scanner. A NULL value sets Valid to false; any other value is
+// scanned as per Clock.Scan and sets Valid to true.
+func (n *NullClock) Scan(value interface{}) error {
+	if value == nil {
+		n.Clock, n.Valid = 0, false
+		return nil
+	}
+	n.Valid = true
+	return n.Clock.Scan(value)
+}
+
+// Value implements driver.Valuer. If Valid is false, the result is nil (i.e. NULL);
+// otherwise it is as per Clock.Value.
+func (n NullClock) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Clock.Value()
+}
+
+// MarshalJSON implements json.Marshaler. If Valid is false, the result is null;
+// otherwise the clock is given as a string, as per MarshalText.
+func (n NullClock) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Clock)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null sets Valid to false; a string
+// is parsed as per UnmarshalText and sets Valid to true.
+func (n *NullClock) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Clock, n.Valid = 0, false
+		return nil
+	}
+	var c Clock
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+	n.Clock, n.Valid = c, true
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullClock_Scan_and_Value(t *testing.T) {
+	cases := []struct {
+		v        interface{}
+		expected NullClock
+	}{
+		{v: nil, expected: NullClock{}},
+		{v: int64(0), expected: NullClock{Clock: Midnight, Valid: true}},
+		{v: "14:30:05", expected: NullClock{Clock: New(14, 30, 5, 0), Valid: true}},
+		{v: []byte("12:00"), expected: NullClock{Clock: Noon, Valid: true}},
+	}
+
+	for i, c := range cases {
+		n := NullClock{Clock: 123, Valid: true}
+		if err := n.Scan(c.v); err != nil {
+			t.Errorf("%d: Scan(%v) gave %v", i, c.v, err)
+		}
+		if n != c.expected {
+			t.Errorf("%d: Scan(%v) gave %+v, want %+v", i, c.v, n, c.expected)
+		}
+
+		v, err := n.Value()
+		if err != nil {
+			t.Errorf("%d: Value() gave %v", i, err)
+		}
+		if c.expected.Valid {
+			if v != Valuer(c.expected.Clock) {
+				t.Errorf("%d: Value() gave %v", i, v)
+			}
+		} else if v != nil {
+			t.Errorf("%d: Value() gave %v, want nil", i, v)
+		}
+	}
+
+	var n NullClock
+	if err := n.Scan(true); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestNullClock_JSON_round_trip(t *testing.T) {
+	type record struct {
+		At NullClock `json:"at"`
+	}
+	cases := []struct {
+		n    NullClock
+		json string
+	}{
+		{n: NullClock{}, json: `{"at":null}`},
+		{n: NullClock{Clock: New(14, 30, 5, 0), Valid: true}, json: `{"at":"14:30:05.000"}`},
+		{n: NullClock{Clock: Midnight, Valid: true}, json: `{"at":"00:00:00.000"}`},
+	}
+
+	for i, c := range cases {
+		bb, err := json.Marshal(record{At: c.n})
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if string(bb) != c.json {
+			t.Errorf("%d: got %s, want %s", i, bb, c.json)
+		}
+
+		r := record{At: NullClock{Clock: 123, Valid: true}}
+		if err = json.Unmarshal(bb, &r); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if r.At != c.n {
+			t.Errorf("%d: got %+v, want %+v", i, r.At, c.n)
+		}
+	}
+
+	var n NullClock
+	if err := json.Unmarshal([]byte(`"bad"`), &n); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -24,17 +24,31 @@ func MustParse(hms string) Clock {
 // Parse converts a string representation to a Clock. Acceptable representations
 // are as per ISO-8601 - see https://en.wikipedia.org/wiki/ISO_8601#Times
 //
-// Also, conventional AM- and PM-based strings are parsed, such as "2am", "2:45pm".
-// Remember that 12am is midnight and 12pm is noon.
+// The fraction of a second may be preceded by either a full stop or a comma,
+// e.g. "14:30:05.250" or "14:30:05,250".
+//
+// Also, conventional AM- and PM-based strings are parsed, such as "2am", "2:45pm" and
+// "2:45 pm". Remember that 12am is midnight and 12pm is noon.
+//
+// Surrounding whitespace is ignored, as is a single leading 'T' (the ISO-8601 time
+// designator), so "T14:30:00" is accepted.
 func Parse(hms string) (clock Clock, err error) {
+	hms = strings.TrimSpace(hms)
+	hms = strings.TrimPrefix(hms, "T")
 	if strings.HasSuffix(hms, "am") || strings.HasSuffix(hms, "AM") {
-		return parseAmPm(hms, 0)
+		return parseAmPm(trimBeforeSuffix(hms), 0)
 	} else if strings.HasSuffix(hms, "pm") || strings.HasSuffix(hms, "PM") {
-		return parseAmPm(hms, 12)
+		return parseAmPm(trimBeforeSuffix(hms), 12)
 	}
 	return parseISO(hms)
 }
 
+// trimBeforeSuffix removes any whitespace between the time and the two-letter am/pm suffix.
+func trimBeforeSuffix(hms string) string {
+	n := len(hms) - 2
+	return strings.TrimRight(hms[:n], " \t") + hms[n:]
+}
+
 func parseISO(hms string) (clock Clock, err error) {
 	switch len(hms) {
 	case 2: // HH
@@ -58,8 +72,8 @@ func parseISO(hms string) (clock Clock, err error) {
 		}
 		return parseClockParts(hms, hms[:2], hms[3:5], hms[6:], "", 0, 0, 0)
 
-	case 9, 10, 11, 12, 13, 14, 15, 16, 17, 18: // HH:MM:SS.000...
-		if hms[2] != ':' || hms[5] != ':' || hms[8] != '.' {
+	case 9, 10, 11, 12, 13, 14, 15, 16, 17, 18: // HH:MM:SS.000... or HH:MM:SS,000...
+		if hms[2] != ':' || hms[5] != ':' || !isDecimalSeparator(hms[8]) {
 			return 0, parseError(hms)
 		}
 		return parseClockParts(hms, hms[:2], hms[3:5], hms[6:8], hms[9:], 9, 0, 0)
@@ -97,19 +111,19 @@ func parseAmPm(hms string, offset int) (clock Clock, err error) {
 		return parseClockParts(hms, h, rest[:2], rest[3:], "", 0, 12, offset)
 
 	case 6, 7: // MM:SS.0xm
-		if rest[2] != ':' || rest[5] != '.' {
+		if rest[2] != ':' || !isDecimalSeparator(rest[5]) {
 			return 0, parseError(hms)
 		}
 		return parseClockParts(hms, h, rest[:2], rest[3:5], rest[6:], 8, 12, offset)
 
 	case 8: // MM:SS.00xm
-		if rest[2] != ':' || rest[5] != '.' {
+		if rest[2] != ':' || !isDecimalSeparator(rest[5]) {
 			return 0, parseError(hms)
 		}
 		return parseClockParts(hms, h, rest[:2], rest[3:5], rest[6:], 7, 12, offset)
 
 	case 9: // MM:SS.000xm
-		if rest[2] != ':' || rest[5] != '.' {
+		if rest[2] != ':' || !isDecimalSeparator(rest[5]) {
 			return 0, parseError(hms)
 		}
 		return parseClockParts(hms, h, rest[:2], rest[3:5], rest[6:], 6, 12, offset)
@@ -117,6 +131,12 @@ func parseAmPm(hms string, offset int) (clock Clock, err error) {
 	return 0, parseError(hms)
 }
 
+// isDecimalSeparator tests for '.' or ',', both of which are allowed by ISO-8601
+// before the fraction of a second.
+func isDecimalSeparator(b byte) bool {
+	return b == '.' || b == ','
+}
+
 func parseClockParts(input, hh, mm, ss, fracs string, zeros, mod, offset int) (clock Clock, err error) {
 	h := 0
 	m := 0
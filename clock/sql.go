@@ -48,7 +48,7 @@ func ValueAsNumber(c Clock) driver.Value {
 	return int64(c)
 }
 
-// ValueAsString returns the string value of a Clock.
+// ValueAsString returns the string value of a Clock, as per MarshalText.
 func ValueAsString(c Clock) driver.Value {
-	return c.String()
+	return c.isoString()
 }
@@ -0,0 +1,100 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadDateColumn reads CSV data and parses the dates in one column, numbered from zero.
+// The first record is treated as a header and is skipped.
+//
+// Each cell is parsed using Parse with the given layout; if the layout is blank, AutoParse
+// is used instead.
+//
+// The result holds one date for every record after the header, in order. If any cells cannot
+// be parsed, their dates are left as zero and the returned error lists every bad cell along
+// with its row number, counting the header as row 1. If the CSV itself is malformed, reading
+// stops and that error is returned.
+func ReadDateColumn(r io.Reader, col int, layout string) ([]Date, error) {
+	if col < 0 {
+		return nil, fmt.Errorf("date.ReadDateColumn: invalid column %d", col)
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	var result []Date
+	var errs []error
+
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("date.ReadDateColumn: %w", err)
+		}
+		if row == 1 {
+			continue // header
+		}
+
+		var d Date
+		if col >= len(record) {
+			err = fmt.Errorf("there is no column %d", col)
+		} else if layout == "" {
+			d, err = AutoParse(record[col])
+		} else {
+			d, err = Parse(layout, record[col])
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", row, err))
+		}
+		result = append(result, d)
+	}
+
+	if len(errs) > 0 {
+		return result, fmt.Errorf("date.ReadDateColumn: %w", errors.Join(errs...))
+	}
+	return result, nil
+}
+
+// WriteDateColumn writes dates as CSV data with one date per record, which suits exporting
+// computed schedules. The first record is a header, "date", so that the output can be read
+// back using ReadDateColumn with column 0.
+//
+// Each date is formatted using Format with the given layout; if the layout is blank, the
+// ISO 8601 format of String is used instead. Cells are quoted where needed, e.g. for a
+// layout containing a comma.
+func WriteDateColumn(w io.Writer, dates []Date, layout string) error {
+	cw := csv.NewWriter(w)
+	record := []string{"date"}
+
+	if err := cw.Write(record); err != nil {
+		return fmt.Errorf("date.WriteDateColumn: %w", err)
+	}
+
+	for _, d := range dates {
+		if layout == "" {
+			record[0] = d.String()
+		} else {
+			record[0] = d.Format(layout)
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("date.WriteDateColumn: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("date.WriteDateColumn: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadDateColumn(t *testing.T) {
+	const data = `name,joined,left
+alice,2020-01-02,02/03/2021
+bob,2019-12-31,31/12/2022
+`
+	cases := []struct {
+		col    int
+		layout string
+		want   string
+	}{
+		{col: 1, layout: "", want: "[2020-01-02 2019-12-31]"},
+		{col: 1, layout: ISO8601, want: "[2020-01-02 2019-12-31]"},
+		{col: 2, layout: "", want: "[2021-03-02 2022-12-31]"},
+		{col: 2, layout: "02/01/2006", want: "[2021-03-02 2022-12-31]"},
+	}
+	for i, c := range cases {
+		list, err := ReadDateColumn(strings.NewReader(data), c.col, c.layout)
+		if err != nil {
+			t.Errorf("%d: unexpected error %v", i, err)
+		} else if fmt.Sprint(list) != c.want {
+			t.Errorf("%d: got %v, want %v", i, list, c.want)
+		}
+	}
+}
+
+func TestReadDateColumn_errors(t *testing.T) {
+	const data = `name,joined
+alice,2020-01-02
+bob,2020-02-30
+carol
+dave,2021-05-06
+`
+	list, err := ReadDateColumn(strings.NewReader(data), 1, ISO8601)
+	if fmt.Sprint(list) != "[2020-01-02 0001-01-01 0001-01-01 2021-05-06]" {
+		t.Errorf("got %v", list)
+	}
+	want := "date.ReadDateColumn: row 3: date.Parse: layout \"2006-01-02\" value \"2020-02-30\": parsing time \"2020-02-30\": day out of range\nrow 4: there is no column 1"
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v\nwant %s", err, want)
+	}
+
+	_, err = ReadDateColumn(strings.NewReader("a\n\"b"), 0, "")
+	if err == nil {
+		t.Errorf("expected error for malformed CSV")
+	}
+
+	_, err = ReadDateColumn(strings.NewReader(data), -1, "")
+	if err == nil {
+		t.Errorf("expected error for negative column")
+	}
+}
+
+func TestWriteDateColumn(t *testing.T) {
+	dates := []Date{
+		New(2020, time.January, 2),
+		New(2019, time.December, 31),
+		New(2024, time.February, 29),
+	}
+	cases := []struct {
+		layout string
+		want   string
+	}{
+		{layout: "", want: "date\n2020-01-02\n2019-12-31\n2024-02-29\n"},
+		{layout: "02/01/2006", want: "date\n02/01/2020\n31/12/2019\n29/02/2024\n"},
+		{layout: "Jan 2, 2006", want: "date\n\"Jan 2, 2020\"\n\"Dec 31, 2019\"\n\"Feb 29, 2024\"\n"},
+	}
+	for i, c := range cases {
+		var buf strings.Builder
+		if err := WriteDateColumn(&buf, dates, c.layout); err != nil {
+			t.Fatalf("%d: unexpected error %v", i, err)
+		}
+		if buf.String() != c.want {
+			t.Errorf("%d: got %q, want %q", i, buf.String(), c.want)
+		}
+
+		list, err := ReadDateColumn(strings.NewReader(buf.String()), 0, c.layout)
+		if err != nil {
+			t.Errorf("%d: unexpected error %v", i, err)
+		} else if !slices.Equal(list, dates) {
+			t.Errorf("%d: got %v, want %v", i, list, dates)
+		}
+	}
+
+	var buf strings.Builder
+	if err := WriteDateColumn(&buf, nil, ""); err != nil || buf.String() != "date\n" {
+		t.Errorf("got %q, %v", buf.String(), err)
+	}
+}
@@ -5,6 +5,7 @@
 package date
 
 import (
+	"fmt"
 	"math"
 	"time"
 
@@ -59,6 +60,14 @@ const (
 	// It is similar to the "Rata Die" numbering system, for which the offset would
 	// be 719163 instead.
 	ZeroOffset = 719162
+
+	// MinDate is the smallest representable date, which is nearly 6 million years in the
+	// past. Unlike Min, it is a constant, so can be used in constant expressions.
+	MinDate Date = math.MinInt32 + 1
+
+	// MaxDate is the largest representable date, which is nearly 6 million years in the
+	// future. Unlike Max, it is a constant, so can be used in constant expressions.
+	MaxDate Date = math.MaxInt32 - ZeroOffset
 )
 
 // New returns the Date value corresponding to the given year, month, and day.
@@ -66,8 +75,59 @@ const (
 // The month and day may be outside their usual ranges and will be normalized
 // during the conversion.
 func New(year int, month time.Month, day int) Date {
-	t := time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
-	return encode(t)
+	return Date(daysFromCivil(year, month, day))
+}
+
+// NewEndOfMonth returns the Date value of the last day of the given month, i.e. the 28th,
+// 29th, 30th or 31st as appropriate; for example, the last day of February is the 29th in
+// leap years. As with New, the month may be outside its usual range and will be normalized.
+func NewEndOfMonth(year int, month time.Month) Date {
+	return Date(daysFromCivil(year, month+1, 1) - 1)
+}
+
+// NewChecked returns the Date value corresponding to the given year, month, and day.
+//
+// Unlike New, the month and day are not normalized; instead, an error is returned if
+// the month is not in the range [1,12] or the day is not within that month. This is
+// useful for validating user inputs.
+func NewChecked(year int, month time.Month, day int) (Date, error) {
+	if month < time.January || month > time.December {
+		return 0, fmt.Errorf("date.NewChecked: month %d is out of range", month)
+	}
+	if day < 1 || day > gregorian.DaysIn(year, month) {
+		return 0, fmt.Errorf("date.NewChecked: day %d is out of range for %04d-%02d", day, year, month)
+	}
+	return New(year, month, day), nil
+}
+
+// ValidDate tests whether the given year, month and day form a real date, i.e. whether
+// the month is in the range [1,12] and the day is within that month. It is a predicate
+// for checking input before constructing a Date; New would normalize invalid values
+// and NewChecked would return an error for them.
+func ValidDate(year int, month time.Month, day int) bool {
+	return month >= time.January && month <= time.December && day >= 1 && day <= gregorian.DaysIn(year, month)
+}
+
+// FromISOWeekDate returns the Date value corresponding to the given ISO 8601 week-numbering
+// year, week and weekday; it is the inverse of ISOWeekDate. The weekday is in the range
+// [1,7] with Monday as 1 and Sunday as 7. An error is returned if the week is not in the
+// range [1,52] or [1,53], depending on the year, or if the weekday is out of range.
+func FromISOWeekDate(year, week, weekday int) (Date, error) {
+	// 28th December is always in the last week of the year
+	if _, weeks := New(year, time.December, 28).ISOWeek(); week < 1 || week > weeks {
+		return 0, fmt.Errorf("date.FromISOWeekDate: week %d is out of range for %04d", week, year)
+	}
+	if weekday < 1 || weekday > 7 {
+		return 0, fmt.Errorf("date.FromISOWeekDate: weekday %d is out of range", weekday)
+	}
+	return isoWeekDate(year, week, weekday), nil
+}
+
+// isoWeekDate converts an ISO 8601 week date without checking its fields.
+func isoWeekDate(year, week, weekday int) Date {
+	// the first week of the year is the one containing 4th January
+	jan4 := New(year, time.January, 4)
+	return jan4 - Date(jan4.ISOWeekday()-1) + Date(7*(week-1)+weekday-1)
 }
 
 // NewAt returns the Date value corresponding to the given time.
@@ -77,31 +137,75 @@ func NewAt(t time.Time) Date {
 	return encode(t)
 }
 
+// FromDaysSinceEpoch returns the Date that is n days after Zero (0001-01-01), which is
+// day 0. Negative values give earlier dates. This is the inverse of DaysSinceEpoch.
+func FromDaysSinceEpoch(n int) Date {
+	return Date(n)
+}
+
+// Now is the source of the current time used by Today, TodayUTC and TodayIn. It is
+// time.Now by default but can be replaced in tests, for example using datetest.Freeze,
+// so that the current date is predictable. It should not be altered while other
+// goroutines may be using it.
+var Now = time.Now
+
 // Today returns today's date according to the current local time.
 func Today() Date {
-	return encode(time.Now())
+	return encode(Now())
 }
 
 // TodayUTC returns today's date according to the current UTC time.
 func TodayUTC() Date {
-	return encode(time.Now().UTC())
+	return encode(Now().UTC())
 }
 
 // TodayIn returns today's date according to the current time relative to
 // the specified location.
 func TodayIn(loc *time.Location) Date {
-	t := time.Now().In(loc)
+	t := Now().In(loc)
 	return encode(t)
 }
 
 // Min returns the smallest representable date, which is nearly 6 million years in the past.
+// This is the same as MinDate.
 func Min() Date {
-	return Date(math.MinInt32 + 1)
+	return MinDate
 }
 
 // Max returns the largest representable date, which is nearly 6 million years in the future.
+// This is the same as MaxDate.
 func Max() Date {
-	return Date(math.MaxInt32 - ZeroOffset)
+	return MaxDate
+}
+
+// DaysSinceEpoch returns the number of days from Zero (0001-01-01), which is day 0,
+// to d. For example, 1970-01-01 is day 719162 (see ZeroOffset). The result is negative
+// for dates before Zero. This is the inverse of FromDaysSinceEpoch.
+func (d Date) DaysSinceEpoch() int {
+	return int(d)
+}
+
+// DaysFrom returns the number of days from epoch to d, which is negative if d is before
+// epoch. This generalises DaysSinceEpoch to any reference date, which is useful for
+// interoperating with systems that count days from some other day zero; for example,
+// spreadsheet serial numbers for dates since March 1900 are the days from 1899-12-30.
+// It is the inverse of DateFromDaysSince. (It is not named DaysSince because that method
+// counts the days since a day of the week.)
+func (d Date) DaysFrom(epoch Date) int {
+	return int(d - epoch)
+}
+
+// DateFromDaysSince returns the date that is n days after epoch; negative values give
+// earlier dates. It is the inverse of DaysFrom.
+func DateFromDaysSince(epoch Date, n int) Date {
+	return epoch + Date(n)
+}
+
+// IsZero reports whether d is Zero, i.e. 0001-01-01, which is day 0. This is the epoch
+// from which dates are counted; it is a valid date like any other and does not mean that
+// the date is missing or unknown. Use NullDate when a date might be absent.
+func (d Date) IsZero() bool {
+	return d == Zero
 }
 
 // MidnightUTC returns a Time value corresponding to midnight on the given date d,
@@ -132,10 +236,43 @@ func (d Date) Time(clock clock.Clock, loc *time.Location) time.Time {
 	return t.Add(time.Duration(-offset) * time.Second).Add(time.Duration(clock))
 }
 
+// AddClock returns the UTC time at the given clock time on date d. The clock is not
+// limited to a single day: a clock of 25:00 (e.g. clock.New(25, 0, 0, 0)) gives 01:00 on
+// the following day and a negative clock gives a time on an earlier day.
+func (d Date) AddClock(c clock.Clock) time.Time {
+	return decode(d).Add(time.Duration(c))
+}
+
+// AddClockIn is as per AddClock except that the time is relative to the specified time
+// zone, as per Time. As with AddClock, a clock beyond 24 hours advances the date. Note that
+// the clock is added to midnight as an elapsed duration, so if a daylight-saving change
+// happens in between, the local clock time of the result will differ by the change.
+func (d Date) AddClockIn(c clock.Clock, loc *time.Location) time.Time {
+	return d.Time(c, loc)
+}
+
+// BeforeTime reports whether midnight UTC at the start of date d is before the instant t.
+// The comparison is between instants, so the zone of t only matters in as much as it
+// determines the instant; the date itself is always taken to start at midnight UTC. So,
+// for example, 2020-06-15 is before 2020-06-15T00:30:00Z but not before
+// 2020-06-15T01:30:00+02:00, which is the same instant as 2020-06-14T23:30:00Z.
+// To compare against midnight in some other zone, use d.MidnightIn(loc).Before(t).
+func (d Date) BeforeTime(t time.Time) bool {
+	return decode(d).Before(t)
+}
+
+// AfterTime reports whether midnight UTC at the start of date d is after the instant t.
+// As with BeforeTime, the date is always taken to start at midnight UTC, whatever the
+// zone of t. Note that if t is exactly midnight UTC on date d, neither BeforeTime nor
+// AfterTime is true.
+func (d Date) AfterTime(t time.Time) bool {
+	return decode(d).After(t)
+}
+
 // Date returns the year, month, and day of d.
 // The first day of the month is 1.
 func (d Date) Date() (year int, month time.Month, day int) {
-	return decode(d).Date()
+	return civilFromDays(int(d))
 }
 
 // LastDayOfMonth returns the last day of the month specified by d.
@@ -148,23 +285,29 @@ func (d Date) LastDayOfMonth() int {
 // Day returns the day of the month specified by d.
 // The first day of the month is 1.
 func (d Date) Day() int {
-	return decode(d).Day()
+	_, _, day := civilFromDays(int(d))
+	return day
 }
 
 // Month returns the month of the year specified by d.
 func (d Date) Month() time.Month {
-	return decode(d).Month()
+	_, month, _ := civilFromDays(int(d))
+	return month
 }
 
-// Year returns the year specified by d.
+// Year returns the year specified by d. Astronomical year numbering is used, so 1 BC is
+// year 0 and earlier years are negative; for example, 0000-01-01 gives 0 and -0001-12-31
+// (i.e. 2 BC) gives -1.
 func (d Date) Year() int {
-	return decode(d).Year()
+	year, _, _ := civilFromDays(int(d))
+	return year
 }
 
 // YearDay returns the day of the year specified by d, in the range [1,365] for
 // non-leap years, and [1,366] in leap years.
 func (d Date) YearDay() int {
-	return decode(d).YearDay()
+	year, _, _ := civilFromDays(int(d))
+	return int(d) - daysFromCivil(year, time.January, 1) + 1
 }
 
 // Weekday returns the day of the week specified by d.
@@ -173,6 +316,17 @@ func (d Date) Weekday() time.Weekday {
 	return time.Weekday((int(ZeroDay) + int(d)%7 + 7) % 7)
 }
 
+// ISOWeekday returns the ISO 8601 day of the week specified by d, which is in the
+// range [1,7] with Monday as 1 and Sunday as 7. In contrast, Weekday uses the
+// convention of time.Weekday, for which Sunday is 0.
+func (d Date) ISOWeekday() int {
+	wd := int(d.Weekday())
+	if wd == 0 {
+		return 7
+	}
+	return wd
+}
+
 // ISOWeek returns the ISO 8601 year and week number in which d occurs.
 // Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
 // week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
@@ -181,6 +335,33 @@ func (d Date) ISOWeek() (year, week int) {
 	return decode(d).ISOWeek()
 }
 
+// ISOWeekDate returns the ISO 8601 week-numbering year, week number and weekday of d,
+// which together form its ISO week date; for example, Friday 1st January 2021 is
+// 2020-W53-5. It combines ISOWeek and ISOWeekday, and FromISOWeekDate is its inverse.
+func (d Date) ISOWeekDate() (year, week, weekday int) {
+	year, week = d.ISOWeek()
+	return year, week, d.ISOWeekday()
+}
+
+// SameYear tests whether d and u are in the same calendar year.
+func (d Date) SameYear(u Date) bool {
+	return d.Year() == u.Year()
+}
+
+// SameMonth tests whether d and u are in the same month of the same year.
+func (d Date) SameMonth(u Date) bool {
+	return d.YearMonth() == u.YearMonth()
+}
+
+// SameISOWeek tests whether d and u are in the same ISO 8601 week. This takes account of
+// the ISO week-numbering year, so for example Tuesday 31st December 2024 and Wednesday
+// 1st January 2025 are both in week 1 of 2025.
+func (d Date) SameISOWeek(u Date) bool {
+	y1, w1 := d.ISOWeek()
+	y2, w2 := u.ISOWeek()
+	return y1 == y2 && w1 == w2
+}
+
 // AddDate returns the date corresponding to adding the given number of years,
 // months, and days to d. For example, AddData(-1, 2, 3) applied to
 // January 1, 2011 returns March 4, 2010.
@@ -197,8 +378,156 @@ func (d Date) AddDate(years, months, days int) Date {
 	return encode(t)
 }
 
+// AddDateChecked is as per AddDate except that it detects overflow. If the result would be
+// outside the range from Min() to Max(), an error is returned instead of a date; neither
+// saturation nor panicking is used, so that callers such as schedule generators can stop
+// cleanly when they go too far.
+func (d Date) AddDateChecked(years, months, days int) (Date, error) {
+	// limits on the inputs that cannot lead to a valid result, so that
+	// the arithmetic below cannot overflow
+	maxYears := int64(Max()-Min())/365 + 1
+	if !within(years, maxYears) || !within(months, 12*maxYears) || !within(days, 366*maxYears) {
+		return 0, fmt.Errorf("date.AddDateChecked: %v plus %d years, %d months, %d days is out of range", d, years, months, days)
+	}
+
+	// adding the days separately gives the same result as AddDate but cannot overflow
+	// on 32-bit platforms
+	r := d.AddDate(years, months, 0) + Date(days)
+	if r < Min() || r > Max() {
+		return 0, fmt.Errorf("date.AddDateChecked: %v plus %d years, %d months, %d days is out of range", d, years, months, days)
+	}
+	return r, nil
+}
+
+func within(x int, limit int64) bool {
+	return -limit <= int64(x) && int64(x) <= limit
+}
+
+// AddDays returns the date corresponding to adding the given number of days to d.
+// The number can be negative. The result is the same as AddDate(0, 0, days) but this
+// is much faster because it uses only integer arithmetic; it is the same as d + Date(days).
+func (d Date) AddDays(days int) Date {
+	return d + Date(days)
+}
+
+// AddWeeks returns the date corresponding to adding the given number of weeks to d.
+// The number can be negative. Like AddDays, this uses only integer arithmetic; it is the
+// same as AddDays(7 * weeks), so the result is always on the same day of the week as d.
+func (d Date) AddWeeks(weeks int) Date {
+	return d + Date(7*weeks)
+}
+
+// AddYears returns the date corresponding to adding the given number of years to d.
+// The number can be negative.
+//
+// Unlike AddDate, the result is not normalized. Instead, 29th February is clamped
+// to 28th February if the resulting year is not a leap year.
+func (d Date) AddYears(years int) Date {
+	return d.AddMonths(years * 12)
+}
+
+// AddMonths returns the date corresponding to adding the given number of months to d.
+// The number can be negative.
+//
+// Unlike AddDate, the result is not normalized. Instead, the day of the month is clamped
+// to the length of the resulting month. For example, adding one month to 31st January
+// gives 28th or 29th February (not 2nd or 3rd March).
+func (d Date) AddMonths(months int) Date {
+	year, month, day := d.Date()
+	m := year*12 + int(month) - 1 + months
+	y, mm := floorDiv(m, 12), time.Month(mod(m, 12)+1)
+	return New(y, mm, min(day, gregorian.DaysIn(y, mm)))
+}
+
+// Hash returns a hash of the date, which is suitable for bucketing or sharding. It uses the
+// SplitMix64 algorithm on the day count, so the result is always the same for the same date,
+// across processes, platforms and Go versions.
+func (d Date) Hash() uint64 {
+	z := uint64(d) + 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Keep can be passed to Replace for any field that should be left unchanged.
+const Keep = math.MinInt
+
+// Replace returns a date that is the same as d except for the fields that are given.
+// Pass Keep for each field that should be left as it is; for example,
+// d.Replace(2025, Keep, Keep) gives the same month and day in 2025.
+//
+// When the day is kept, it is clamped to the length of the resulting month. So
+// replacing the year of 29th February 2024 with 2025 gives 28th February 2025
+// (not 1st March). Otherwise, as with New, the month and day may be outside their
+// usual ranges and will be normalized.
+func (d Date) Replace(year int, month time.Month, day int) Date {
+	y, m, dd := d.Date()
+	if year != Keep {
+		y = year
+	}
+	if month != Keep {
+		m = month
+	}
+	if day != Keep {
+		return New(y, m, day)
+	}
+
+	// normalise the month before clamping the day
+	first := New(y, m, 1)
+	y, m, _ = first.Date()
+	return New(y, m, min(dd, gregorian.DaysIn(y, m)))
+}
+
+// PeriodBetween returns the calendar period from one date to another, in whole years,
+// months and days, the way a person would read the span (e.g. "P2Y3M5D"). If to is
+// before from, all the fields are negative.
+//
+// When the day of the month of to is earlier than that of from, a month is borrowed,
+// so the days include the remainder of the month before to. For example, from 15th
+// January to 10th March 2024 is one month and 24 days, because February has 29 days.
+//
+// The result is consistent with AddPeriod, so that from.AddPeriod(PeriodBetween(from, to))
+// is always to. Because AddPeriod normalizes rather than clamping the day of the month,
+// the months can be fewer than MonthsBetween gives near the end of a month; for example,
+// from 31st January to 28th February 2023 is 28 days.
+func PeriodBetween(from, to Date) period.Period {
+	y1, m1, _ := from.Date()
+	y2, m2, _ := to.Date()
+	months := (y2-y1)*12 + int(m2-m1)
+
+	if to >= from {
+		for months > 0 && from.AddDate(0, months, 0) > to {
+			months--
+		}
+	} else {
+		for months < 0 && from.AddDate(0, months, 0) < to {
+			months++
+		}
+	}
+
+	days := int(to - from.AddDate(0, months, 0))
+	return period.NewYMD(months/12, months%12, days)
+}
+
+// Until returns the calendar period from d to u, as per PeriodBetween(d, u). The result is
+// positive when u is after d and negative when u is before d; for example, if u is three
+// months and four days after d, the result is "P3M4D". So d.AddPeriod(d.Until(u)) is u.
+func (d Date) Until(u Date) period.Period {
+	return PeriodBetween(d, u)
+}
+
+// Since returns the calendar period from u to d, as per PeriodBetween(u, d). The result is
+// positive when d is after u, i.e. when u is in the past relative to d, which suits phrases
+// such as "3 months and 4 days ago". So d.Since(u) is the same as u.Until(d), and
+// u.AddPeriod(d.Since(u)) is d.
+func (d Date) Since(u Date) period.Period {
+	return PeriodBetween(u, d)
+}
+
 // AddPeriod returns the date corresponding to adding the given period. If the
-// period's fields are be negative, this results in an earlier date.
+// period's fields are negative, this results in an earlier date. A period with a
+// leading minus sign, such as "-P1Y2M" parsed by period.Parse, is negative in all
+// its fields, so adding it subtracts one year and two months.
 //
 // Any time component only affects the result for periods containing
 // more that 24 hours in the hours/minutes/seconds fields
@@ -207,3 +536,51 @@ func (d Date) AddPeriod(delta period.Period) Date {
 	t2, _ := delta.AddTo(t1)
 	return encode(t2)
 }
+
+// DurationSince returns the duration d - other, assuming that every day has exactly 24 hours
+// (as in UTC). The result is negative if d is before other.
+//
+// Because time.Duration is limited to approximately 292 years, the result will hard-limit
+// to the minimum or maximum possible duration if the dates are further apart than that
+// (see time.Sub(t)).
+func (d Date) DurationSince(other Date) time.Duration {
+	days := int64(d) - int64(other)
+	const maxDays = int64(math.MaxInt64 / (secondsPerDay * time.Second))
+	switch {
+	case days > maxDays:
+		return time.Duration(math.MaxInt64)
+	case days < -maxDays:
+		return time.Duration(math.MinInt64)
+	}
+	return time.Duration(days) * secondsPerDay * time.Second
+}
+
+// MonthsBetween returns the number of whole calendar months from one date to another.
+// A month is complete on the monthly anniversary of from, i.e. the same day of the month.
+// If that day does not exist in a shorter month, the anniversary falls on the last day of
+// that month instead. For example, from 31st January 2020 to 29th February 2020 is one
+// whole month, but to 28th February 2020 is zero months.
+//
+// If to is before from, the result is negative and is computed as -MonthsBetween(to, from).
+func MonthsBetween(from, to Date) int {
+	if to < from {
+		return -MonthsBetween(to, from)
+	}
+
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	months := (y2-y1)*12 + int(m2-m1)
+	if d2 < d1 && d2 < gregorian.DaysIn(y2, m2) {
+		months--
+	}
+	return months
+}
+
+// YearsBetween returns the number of whole calendar years from one date to another.
+// It follows the same anniversary rule as MonthsBetween, so from 29th February 2020 to
+// 28th February 2021 is one whole year.
+//
+// If to is before from, the result is negative.
+func YearsBetween(from, to Date) int {
+	return MonthsBetween(from, to) / 12
+}
@@ -6,6 +6,7 @@ package date
 
 import (
 	"fmt"
+	"math"
 	"runtime/debug"
 	"testing"
 	"time"
@@ -203,6 +204,90 @@ func testPredicate(t *testing.T, di, dj Date, p, q bool, m string) {
 	}
 }
 
+func TestDate_IsZero(t *testing.T) {
+	cases := []struct {
+		d    Date
+		want bool
+	}{
+		{Zero, true},
+		{New(1, time.January, 1), true},
+		{New(1970, time.January, 1), false},
+		{New(0, time.December, 31), false},
+		{New(1, time.January, 2), false},
+	}
+	for i, c := range cases {
+		if got := c.d.IsZero(); got != c.want {
+			t.Errorf("%d: %v.IsZero() == %v, want %v", i, c.d, got, c.want)
+		}
+	}
+}
+
+func TestDate_Year(t *testing.T) {
+	cases := []struct {
+		d    Date
+		want int
+	}{
+		{New(0, time.January, 1), 0},
+		{New(0, time.December, 31), 0},
+		{New(1, time.January, 1) - 1, 0},
+		{New(-1, time.January, 1), -1},
+		{New(-1, time.December, 31), -1},
+		{New(0, time.January, 1) - 1, -1},
+		{New(-752, time.April, 21), -752},
+		{New(1, time.January, 1), 1},
+		{New(2024, time.February, 29), 2024},
+		{New(12345, time.June, 7), 12345},
+		{New(5000000, time.December, 31), 5000000},
+		{MustParseISO("-0001-12-31"), -1},
+		{MustParseISO("0000-01-01"), 0},
+	}
+	for i, c := range cases {
+		if got := c.d.Year(); got != c.want {
+			t.Errorf("%d: %v.Year() == %d, want %d", i, c.d, got, c.want)
+		}
+		if y, _, _ := c.d.Date(); y != c.want {
+			t.Errorf("%d: %v.Date() year %d, want %d", i, c.d, y, c.want)
+		}
+	}
+}
+
+func TestDate_Month_and_Day(t *testing.T) {
+	cases := []struct {
+		d     Date
+		month time.Month
+		day   int
+	}{
+		{New(2023, time.December, 31), time.December, 31},
+		{New(2024, time.January, 1), time.January, 1},
+		{New(2024, time.January, 31), time.January, 31},
+		{New(2024, time.February, 1), time.February, 1},
+		{New(2024, time.February, 29), time.February, 29},
+		{New(2024, time.March, 1), time.March, 1},
+		{New(2023, time.February, 28), time.February, 28},
+		{New(2023, time.March, 1), time.March, 1},
+		{New(2024, time.April, 30), time.April, 30},
+		{New(2024, time.May, 1), time.May, 1},
+		{New(-1, time.December, 31), time.December, 31},
+		{New(0, time.January, 1), time.January, 1},
+	}
+	for i, c := range cases {
+		if got := c.d.Month(); got != c.month {
+			t.Errorf("%d: %v.Month() == %v, want %v", i, c.d, got, c.month)
+		}
+		if got := c.d.Day(); got != c.day {
+			t.Errorf("%d: %v.Day() == %d, want %d", i, c.d, got, c.day)
+		}
+	}
+
+	// every day of a leap year agrees with Date
+	for d := New(2024, time.January, 1); d < New(2025, time.January, 1); d++ {
+		_, month, day := d.Date()
+		if d.Month() != month || d.Day() != day {
+			t.Fatalf("%v: got %v %d", d, d.Month(), d.Day())
+		}
+	}
+}
+
 func TestDate_AddDate(t *testing.T) {
 	cases := []struct {
 		d                   Date
@@ -256,3 +341,678 @@ func TestDate_AddPeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestDate_AddPeriod_negative(t *testing.T) {
+	d := New(2024, time.March, 31)
+	cases := []struct {
+		iso      string
+		expected Date
+	}{
+		{iso: "-P1D", expected: d.AddDays(-1)},
+		{iso: "-P1M", expected: New(2024, time.March, 2)}, // 31st February normalises
+		{iso: "-P1Y2M", expected: New(2023, time.January, 31)},
+		{iso: "-P1Y2M3D", expected: New(2023, time.January, 28)},
+		{iso: "-P2W", expected: New(2024, time.March, 17)},
+	}
+	for i, c := range cases {
+		delta, err := period.Parse(c.iso)
+		if err != nil {
+			t.Fatalf("%d: period.Parse(%q) unexpected error %v", i, c.iso, err)
+		}
+		if out := d.AddPeriod(delta); out != c.expected {
+			t.Errorf("%d: %v.AddPeriod(%s) == %v, want %v", i, d, c.iso, out, c.expected)
+		}
+		// the sign applies to the whole period, not just its first field
+		if !delta.IsNegative() || delta.Years() > 0 || delta.Months() > 0 || delta.Weeks() > 0 || delta.Days() > 0 {
+			t.Errorf("%d: period.Parse(%q) == %v, want all fields negative or zero", i, c.iso, delta)
+		}
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	cases := []struct {
+		from, to      Date
+		months, years int
+	}{
+		{from: New(2020, time.January, 15), to: New(2020, time.January, 15), months: 0, years: 0},
+		// exact anniversaries
+		{from: New(2020, time.January, 15), to: New(2020, time.February, 15), months: 1, years: 0},
+		{from: New(2020, time.January, 15), to: New(2021, time.January, 15), months: 12, years: 1},
+		// a day short
+		{from: New(2020, time.January, 15), to: New(2020, time.February, 14), months: 0, years: 0},
+		{from: New(2020, time.January, 15), to: New(2021, time.January, 14), months: 11, years: 0},
+		// a day over
+		{from: New(2020, time.January, 15), to: New(2020, time.February, 16), months: 1, years: 0},
+		{from: New(2020, time.January, 15), to: New(2021, time.January, 16), months: 12, years: 1},
+		// end-of-month anniversaries
+		{from: New(2020, time.January, 31), to: New(2020, time.February, 28), months: 0, years: 0},
+		{from: New(2020, time.January, 31), to: New(2020, time.February, 29), months: 1, years: 0},
+		{from: New(2020, time.January, 31), to: New(2020, time.April, 30), months: 3, years: 0},
+		{from: New(2020, time.February, 29), to: New(2021, time.February, 28), months: 12, years: 1},
+		{from: New(2020, time.February, 29), to: New(2021, time.February, 27), months: 11, years: 0},
+		// reversed
+		{from: New(2021, time.January, 15), to: New(2020, time.January, 15), months: -12, years: -1},
+		{from: New(2020, time.February, 14), to: New(2020, time.January, 15), months: 0, years: 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s %s", i, c.from, c.to), func(t *testing.T) {
+			months := MonthsBetween(c.from, c.to)
+			if months != c.months {
+				t.Errorf("%d: MonthsBetween(%v, %v) == %d, want %d", i, c.from, c.to, months, c.months)
+			}
+			years := YearsBetween(c.from, c.to)
+			if years != c.years {
+				t.Errorf("%d: YearsBetween(%v, %v) == %d, want %d", i, c.from, c.to, years, c.years)
+			}
+		})
+	}
+}
+
+func TestDate_DurationSince(t *testing.T) {
+	cases := []struct {
+		d, other Date
+		want     time.Duration
+	}{
+		{d: New(2024, time.March, 1), other: New(2024, time.March, 1), want: 0},
+		{d: New(2024, time.March, 1), other: New(2024, time.February, 28), want: 48 * time.Hour},
+		{d: New(2024, time.February, 28), other: New(2024, time.March, 1), want: -48 * time.Hour},
+		{d: New(2262, time.April, 11), other: New(1970, time.January, 1), want: 106751 * 24 * time.Hour},
+		{d: New(2262, time.April, 12), other: New(1970, time.January, 1), want: math.MaxInt64},
+		{d: New(1970, time.January, 1), other: New(2262, time.April, 12), want: math.MinInt64},
+		{d: Max(), other: Min(), want: math.MaxInt64},
+		{d: Min(), other: Max(), want: math.MinInt64},
+	}
+	for i, c := range cases {
+		got := c.d.DurationSince(c.other)
+		if got != c.want {
+			t.Errorf("%d: %v.DurationSince(%v) == %v, want %v", i, c.d, c.other, got, c.want)
+		}
+	}
+}
+
+func TestNewEndOfMonth(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+	}{
+		{year: 2023, month: time.January, day: 31},
+		{year: 2023, month: time.February, day: 28},
+		{year: 2023, month: time.March, day: 31},
+		{year: 2023, month: time.April, day: 30},
+		{year: 2023, month: time.May, day: 31},
+		{year: 2023, month: time.June, day: 30},
+		{year: 2023, month: time.July, day: 31},
+		{year: 2023, month: time.August, day: 31},
+		{year: 2023, month: time.September, day: 30},
+		{year: 2023, month: time.October, day: 31},
+		{year: 2023, month: time.November, day: 30},
+		{year: 2023, month: time.December, day: 31},
+		{year: 2024, month: time.February, day: 29},
+		{year: 2000, month: time.February, day: 29},
+		{year: 1900, month: time.February, day: 28},
+		{year: -4, month: time.February, day: 29},
+	}
+	for i, c := range cases {
+		d := NewEndOfMonth(c.year, c.month)
+		if d != New(c.year, c.month, c.day) {
+			t.Errorf("%d: NewEndOfMonth(%d, %v) == %v, want day %d", i, c.year, c.month, d, c.day)
+		}
+	}
+
+	// normalized months
+	if d := NewEndOfMonth(2023, 14); d != New(2024, time.February, 29) {
+		t.Errorf("NewEndOfMonth(2023, 14) == %v", d)
+	}
+	if d := NewEndOfMonth(2024, 0); d != New(2023, time.December, 31) {
+		t.Errorf("NewEndOfMonth(2024, 0) == %v", d)
+	}
+}
+
+func TestNewChecked_and_ValidDate(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+		ok    bool
+	}{
+		{year: 2020, month: time.January, day: 1, ok: true},
+		{year: 2020, month: time.December, day: 31, ok: true},
+		{year: 2020, month: time.February, day: 29, ok: true},
+		{year: 2000, month: time.February, day: 29, ok: true},
+		{year: 0, month: time.February, day: 29, ok: true},
+		{year: 2021, month: time.February, day: 29, ok: false},
+		{year: 1900, month: time.February, day: 29, ok: false},
+		{year: 2020, month: time.February, day: 30, ok: false},
+		{year: 2020, month: time.April, day: 31, ok: false},
+		{year: 2020, month: 0, day: 1, ok: false},
+		{year: 2020, month: 13, day: 1, ok: false},
+		{year: 2020, month: time.January, day: 0, ok: false},
+		{year: 2020, month: time.January, day: 32, ok: false},
+		{year: 2020, month: time.January, day: -1, ok: false},
+	}
+	for i, c := range cases {
+		if ValidDate(c.year, c.month, c.day) != c.ok {
+			t.Errorf("%d: ValidDate(%d, %d, %d) != %v", i, c.year, c.month, c.day, c.ok)
+		}
+		d, err := NewChecked(c.year, c.month, c.day)
+		if c.ok {
+			if err != nil {
+				t.Errorf("%d: NewChecked(%d, %d, %d) unexpected error %v", i, c.year, c.month, c.day, err)
+			} else if d != New(c.year, c.month, c.day) {
+				t.Errorf("%d: NewChecked(%d, %d, %d) == %v", i, c.year, c.month, c.day, d)
+			}
+		} else if err == nil {
+			t.Errorf("%d: NewChecked(%d, %d, %d) == %v, want error", i, c.year, c.month, c.day, d)
+		}
+	}
+}
+
+func TestDate_AddYears_and_AddMonths(t *testing.T) {
+	cases := []struct {
+		d      Date
+		years  int
+		months int
+		want   Date
+	}{
+		{d: New(2020, time.February, 29), years: 1, want: New(2021, time.February, 28)},
+		{d: New(2020, time.February, 29), years: -1, want: New(2019, time.February, 28)},
+		{d: New(2020, time.February, 29), years: 4, want: New(2024, time.February, 29)},
+		{d: New(2020, time.February, 29), years: -20, want: New(2000, time.February, 29)},
+		{d: New(2000, time.February, 29), years: 100, want: New(2100, time.February, 28)},
+		{d: New(2021, time.February, 28), years: -1, want: New(2020, time.February, 28)},
+		{d: New(2020, time.January, 31), months: 1, want: New(2020, time.February, 29)},
+		{d: New(2021, time.January, 31), months: 1, want: New(2021, time.February, 28)},
+		{d: New(2020, time.March, 31), months: -1, want: New(2020, time.February, 29)},
+		{d: New(2021, time.March, 31), months: -1, want: New(2021, time.February, 28)},
+		{d: New(2020, time.August, 31), months: 1, want: New(2020, time.September, 30)},
+		{d: New(2020, time.December, 31), months: 2, want: New(2021, time.February, 28)},
+		{d: New(2020, time.January, 15), months: -13, want: New(2018, time.December, 15)},
+		{d: New(0, time.March, 31), months: -4, want: New(-1, time.November, 30)},
+	}
+	for i, c := range cases {
+		if c.years != 0 {
+			got := c.d.AddYears(c.years)
+			if got != c.want {
+				t.Errorf("%d: %v.AddYears(%d) == %v, want %v", i, c.d, c.years, got, c.want)
+			}
+		} else {
+			got := c.d.AddMonths(c.months)
+			if got != c.want {
+				t.Errorf("%d: %v.AddMonths(%d) == %v, want %v", i, c.d, c.months, got, c.want)
+			}
+		}
+	}
+}
+
+func TestDate_DaysSinceEpoch(t *testing.T) {
+	cases := []struct {
+		d Date
+		n int
+	}{
+		{d: New(0, time.December, 31), n: -1},
+		{d: New(1, time.January, 1), n: 0},
+		{d: New(1, time.January, 2), n: 1},
+		{d: New(1970, time.January, 1), n: ZeroOffset},
+		{d: New(2000, time.January, 1), n: 730119},
+	}
+	for i, c := range cases {
+		n := c.d.DaysSinceEpoch()
+		if n != c.n {
+			t.Errorf("%d: %v.DaysSinceEpoch() == %d, want %d", i, c.d, n, c.n)
+		}
+		d := FromDaysSinceEpoch(c.n)
+		if d != c.d {
+			t.Errorf("%d: FromDaysSinceEpoch(%d) == %v, want %v", i, c.n, d, c.d)
+		}
+	}
+}
+
+func TestDate_DaysFrom_and_DateFromDaysSince(t *testing.T) {
+	// spreadsheet serial numbers count from 1899-12-30 for dates since March 1900
+	spreadsheet := New(1899, time.December, 30)
+	unix := New(1970, time.January, 1)
+	cases := []struct {
+		epoch Date
+		d     Date
+		n     int
+	}{
+		{epoch: spreadsheet, d: New(1900, time.March, 1), n: 61},
+		{epoch: spreadsheet, d: New(2020, time.January, 1), n: 43831},
+		{epoch: spreadsheet, d: New(2024, time.February, 29), n: 45351},
+		{epoch: spreadsheet, d: spreadsheet, n: 0},
+		{epoch: spreadsheet, d: New(1899, time.December, 1), n: -29},
+		{epoch: unix, d: New(2000, time.January, 1), n: 10957},
+		{epoch: Zero, d: New(1970, time.January, 1), n: ZeroOffset},
+	}
+	for i, c := range cases {
+		if n := c.d.DaysFrom(c.epoch); n != c.n {
+			t.Errorf("%d: %v.DaysFrom(%v) == %d, want %d", i, c.d, c.epoch, n, c.n)
+		}
+		if d := DateFromDaysSince(c.epoch, c.n); d != c.d {
+			t.Errorf("%d: DateFromDaysSince(%v, %d) == %v, want %v", i, c.epoch, c.n, d, c.d)
+		}
+		if c.epoch == Zero && c.d.DaysFrom(c.epoch) != c.d.DaysSinceEpoch() {
+			t.Errorf("%d: inconsistent with DaysSinceEpoch", i)
+		}
+	}
+}
+
+func TestDate_ISOWeekday(t *testing.T) {
+	d := New(2024, time.March, 3) // Sunday
+	cases := []struct {
+		weekday time.Weekday
+		iso     int
+	}{
+		{weekday: time.Sunday, iso: 7},
+		{weekday: time.Monday, iso: 1},
+		{weekday: time.Tuesday, iso: 2},
+		{weekday: time.Wednesday, iso: 3},
+		{weekday: time.Thursday, iso: 4},
+		{weekday: time.Friday, iso: 5},
+		{weekday: time.Saturday, iso: 6},
+	}
+	for i, c := range cases {
+		for _, di := range []Date{d + Date(i), d + Date(i) - 700000, d + Date(i) + 700000} {
+			if di.Weekday() != c.weekday {
+				t.Errorf("%d: %v.Weekday() == %v, want %v", i, di, di.Weekday(), c.weekday)
+			}
+			if di.ISOWeekday() != c.iso {
+				t.Errorf("%d: %v.ISOWeekday() == %d, want %d", i, di, di.ISOWeekday(), c.iso)
+			}
+		}
+	}
+}
+
+func TestDate_ISOWeekDate(t *testing.T) {
+	cases := []struct {
+		value               Date
+		year, week, weekday int
+	}{
+		{value: New(2021, time.January, 1), year: 2020, week: 53, weekday: 5},
+		{value: New(2021, time.January, 4), year: 2021, week: 1, weekday: 1},
+		{value: New(2024, time.December, 31), year: 2025, week: 1, weekday: 2},
+		{value: New(2008, time.December, 29), year: 2009, week: 1, weekday: 1},
+		{value: New(2010, time.January, 3), year: 2009, week: 53, weekday: 7},
+		{value: New(2005, time.January, 1), year: 2004, week: 53, weekday: 6},
+		{value: New(2006, time.August, 5), year: 2006, week: 31, weekday: 6},
+	}
+	for i, c := range cases {
+		year, week, weekday := c.value.ISOWeekDate()
+		if year != c.year || week != c.week || weekday != c.weekday {
+			t.Errorf("%d: %v.ISOWeekDate() == %d, %d, %d, want %d, %d, %d", i, c.value, year, week, weekday, c.year, c.week, c.weekday)
+		}
+		d, err := FromISOWeekDate(c.year, c.week, c.weekday)
+		if err != nil {
+			t.Errorf("%d: FromISOWeekDate(%d, %d, %d) unexpected error %v", i, c.year, c.week, c.weekday, err)
+		} else if d != c.value {
+			t.Errorf("%d: FromISOWeekDate(%d, %d, %d) == %v, want %v", i, c.year, c.week, c.weekday, d, c.value)
+		}
+	}
+}
+
+func TestDate_ISOWeekDate_roundTrip(t *testing.T) {
+	for d := New(1999, time.December, 1); d < New(2030, time.February, 1); d++ {
+		year, week, weekday := d.ISOWeekDate()
+		got, err := FromISOWeekDate(year, week, weekday)
+		if err != nil || got != d {
+			t.Fatalf("FromISOWeekDate(%v.ISOWeekDate()) == %v, %v", d, got, err)
+		}
+	}
+}
+
+func TestFromISOWeekDate_errors(t *testing.T) {
+	cases := []struct {
+		year, week, weekday int
+	}{
+		{year: 2021, week: 53, weekday: 1}, // 2021 has only 52 weeks
+		{year: 2020, week: 54, weekday: 1},
+		{year: 2020, week: 0, weekday: 1},
+		{year: 2020, week: 1, weekday: 0},
+		{year: 2020, week: 1, weekday: 8},
+	}
+	for i, c := range cases {
+		d, err := FromISOWeekDate(c.year, c.week, c.weekday)
+		if err == nil {
+			t.Errorf("%d: FromISOWeekDate(%d, %d, %d) == %v, want error", i, c.year, c.week, c.weekday, d)
+		}
+	}
+}
+
+func TestDate_AddDays(t *testing.T) {
+	offsets := []int{-1000000, -36525, -366, -365, -31, -1, 0, 1, 28, 29, 365, 366, 36524, 1000000}
+	for d := Min() + 1000000; d < Max()-1000000; d += 9973 {
+		for _, n := range offsets {
+			got := d.AddDays(n)
+			want := d.AddDate(0, 0, n)
+			if got != want {
+				t.Fatalf("%v.AddDays(%d) == %v, want %v", d, n, got, want)
+			}
+		}
+	}
+}
+
+func TestDate_AddDateChecked(t *testing.T) {
+	cases := []struct {
+		d                   Date
+		years, months, days int
+		want                Date
+		ok                  bool
+	}{
+		{d: New(2020, time.January, 31), months: 1, want: New(2020, time.March, 2), ok: true},
+		{d: Max(), days: -1, want: Max() - 1, ok: true},
+		{d: Max() - 1, days: 1, want: Max(), ok: true},
+		{d: Max(), days: 1},
+		{d: Max(), months: 1},
+		{d: Max(), years: 1},
+		{d: Min() + 1, days: -1, want: Min(), ok: true},
+		{d: Min(), days: -1},
+		{d: Min(), years: -1},
+		{d: Min(), years: 1, want: Min().AddDate(1, 0, 0), ok: true},
+		{d: New(2020, time.January, 1), years: math.MaxInt},
+		{d: New(2020, time.January, 1), months: math.MinInt},
+		{d: New(2020, time.January, 1), days: math.MaxInt},
+	}
+	for i, c := range cases {
+		r, err := c.d.AddDateChecked(c.years, c.months, c.days)
+		if c.ok {
+			if err != nil || r != c.want {
+				t.Errorf("%d: %v.AddDateChecked(%d, %d, %d) == %v, %v; want %v", i, c.d, c.years, c.months, c.days, r, err, c.want)
+			}
+		} else if err == nil {
+			t.Errorf("%d: %v.AddDateChecked(%d, %d, %d) == %v; want an error", i, c.d, c.years, c.months, c.days, r)
+		}
+	}
+}
+
+func TestDate_Replace(t *testing.T) {
+	cases := []struct {
+		d                Date
+		year, month, day int
+		want             Date
+	}{
+		{d: New(2020, time.May, 15), year: Keep, month: Keep, day: Keep, want: New(2020, time.May, 15)},
+		{d: New(2020, time.May, 15), year: 2025, month: Keep, day: Keep, want: New(2025, time.May, 15)},
+		{d: New(2020, time.May, 15), year: Keep, month: 11, day: Keep, want: New(2020, time.November, 15)},
+		{d: New(2020, time.May, 15), year: Keep, month: Keep, day: 1, want: New(2020, time.May, 1)},
+		{d: New(2020, time.May, 15), year: -1, month: 1, day: Keep, want: New(-1, time.January, 15)},
+		// the kept day is clamped
+		{d: New(2024, time.February, 29), year: 2025, month: Keep, day: Keep, want: New(2025, time.February, 28)},
+		{d: New(2024, time.February, 29), year: 2028, month: Keep, day: Keep, want: New(2028, time.February, 29)},
+		{d: New(2024, time.January, 31), year: Keep, month: 4, day: Keep, want: New(2024, time.April, 30)},
+		{d: New(2024, time.January, 31), year: Keep, month: 14, day: Keep, want: New(2025, time.February, 28)},
+		// a given day is normalised
+		{d: New(2025, time.January, 31), year: Keep, month: 2, day: 30, want: New(2025, time.March, 2)},
+	}
+	for i, c := range cases {
+		r := c.d.Replace(c.year, time.Month(c.month), c.day)
+		if r != c.want {
+			t.Errorf("%d: %v.Replace(%d, %d, %d) == %v, want %v", i, c.d, c.year, c.month, c.day, r, c.want)
+		}
+	}
+}
+
+func TestMinDate_and_MaxDate(t *testing.T) {
+	if MinDate != Min() || MaxDate != Max() {
+		t.Errorf("got %v, %v; want %v, %v", MinDate, MaxDate, Min(), Max())
+	}
+
+	// usable in constant expressions
+	const span = MaxDate - MinDate
+	if span != Max()-Min() {
+		t.Errorf("got %d", span)
+	}
+
+	switch d := Max(); d {
+	case MaxDate:
+	default:
+		t.Errorf("%v is not MaxDate", d)
+	}
+}
+
+func TestDate_Hash(t *testing.T) {
+	// these values must never change
+	cases := []struct {
+		d    Date
+		want uint64
+	}{
+		{d: Zero, want: 0xe220a8397b1dcdaf},
+		{d: New(1970, time.January, 1), want: 0x0afd6523090307c9},
+		{d: Zero - 1, want: 0xe4d971771b652c20},
+	}
+	for i, c := range cases {
+		if h := c.d.Hash(); h != c.want {
+			t.Errorf("%d: %v.Hash() == %#x, want %#x", i, c.d, h, c.want)
+		}
+	}
+
+	d1 := New(2020, time.March, 1)
+	d2 := MustParseISO("2020-03-01")
+	if d1.Hash() != d2.Hash() {
+		t.Errorf("%v and %v hash differently", d1, d2)
+	}
+
+	// neighbouring dates should spread across buckets
+	buckets := make(map[uint64]int)
+	for d := d1; d < d1+1000; d++ {
+		buckets[d.Hash()%8]++
+	}
+	for b, n := range buckets {
+		if n < 80 || n > 170 {
+			t.Errorf("bucket %d has %d dates", b, n)
+		}
+	}
+}
+
+func TestPeriodBetween(t *testing.T) {
+	cases := []struct {
+		from, to Date
+		want     string
+	}{
+		{from: New(2024, time.January, 15), to: New(2024, time.January, 15), want: "P0D"},
+		{from: New(2020, time.January, 10), to: New(2022, time.April, 15), want: "P2Y3M5D"},
+		// borrowing across a short February
+		{from: New(2024, time.January, 15), to: New(2024, time.March, 10), want: "P1M24D"},
+		{from: New(2023, time.January, 15), to: New(2023, time.March, 10), want: "P1M23D"},
+		// month ends
+		{from: New(2023, time.January, 31), to: New(2023, time.February, 28), want: "P28D"},
+		{from: New(2024, time.January, 31), to: New(2024, time.February, 29), want: "P29D"},
+		{from: New(2023, time.January, 31), to: New(2023, time.March, 1), want: "P29D"},
+		{from: New(2024, time.January, 31), to: New(2024, time.March, 1), want: "P30D"},
+		{from: New(2023, time.January, 31), to: New(2023, time.March, 30), want: "P1M27D"},
+		{from: New(2023, time.January, 31), to: New(2023, time.March, 31), want: "P2M"},
+		// leap days
+		{from: New(2020, time.February, 29), to: New(2021, time.February, 28), want: "P11M30D"},
+		{from: New(2020, time.February, 29), to: New(2021, time.March, 1), want: "P1Y"},
+		{from: New(2020, time.February, 29), to: New(2024, time.February, 29), want: "P4Y"},
+		{from: New(2023, time.December, 20), to: New(2024, time.January, 5), want: "P16D"},
+		{from: New(-1, time.June, 1), to: New(1, time.June, 1), want: "P2Y"},
+		// reversed
+		{from: New(2022, time.April, 15), to: New(2020, time.January, 10), want: "-P2Y3M5D"},
+		{from: New(2023, time.May, 31), to: New(2023, time.March, 2), want: "-P2M29D"},
+		{from: New(2023, time.March, 31), to: New(2023, time.February, 28), want: "-P1M3D"},
+		{from: New(2021, time.February, 28), to: New(2020, time.February, 29), want: "-P11M28D"},
+	}
+	for i, c := range cases {
+		p := PeriodBetween(c.from, c.to)
+		if p.String() != c.want {
+			t.Errorf("%d: PeriodBetween(%v, %v) == %v, want %s", i, c.from, c.to, p, c.want)
+		}
+		if c.from.AddPeriod(p) != c.to {
+			t.Errorf("%d: %v + %v == %v, want %v", i, c.from, p, c.from.AddPeriod(p), c.to)
+		}
+	}
+}
+
+func TestPeriodBetween_AddPeriod_consistency(t *testing.T) {
+	// starting dates include month ends and a leap day
+	starts := []Date{New(2023, time.January, 25), New(2024, time.January, 31), New(2020, time.February, 29)}
+	for _, d0 := range starts {
+		for from := d0 - 3; from < d0+40; from++ {
+			for to := d0 - 800; to < d0+800; to += 3 {
+				p := PeriodBetween(from, to)
+				if from.AddPeriod(p) != to {
+					t.Fatalf("%v + %v == %v, want %v", from, p, from.AddPeriod(p), to)
+				}
+			}
+		}
+	}
+}
+
+func TestDate_Until_and_Since(t *testing.T) {
+	d := New(2024, time.January, 15)
+	u := New(2024, time.April, 19)
+
+	if p := d.Until(u); p.String() != "P3M4D" {
+		t.Errorf("%v.Until(%v) == %v", d, u, p)
+	}
+	if p := u.Since(d); p.String() != "P3M4D" {
+		t.Errorf("%v.Since(%v) == %v", u, d, p)
+	}
+	if p := u.Until(d); !p.IsNegative() {
+		t.Errorf("%v.Until(%v) == %v, want negative", u, d, p)
+	}
+
+	// month ends and leap days, as well as ordinary dates
+	starts := []Date{New(2023, time.January, 25), New(2023, time.January, 31), New(2024, time.February, 29)}
+	for _, d0 := range starts {
+		for d := d0 - 3; d < d0+40; d++ {
+			for u := d0 - 400; u < d0+800; u += 5 {
+				p := d.Until(u)
+				if u.Since(d) != p {
+					t.Fatalf("%v.Since(%v) == %v, want %v", u, d, u.Since(d), p)
+				}
+				if d.AddPeriod(p) != u {
+					t.Fatalf("%v + %v == %v, want %v", d, p, d.AddPeriod(p), u)
+				}
+				if u.AddPeriod(d.Since(u)) != d {
+					t.Fatalf("%v + %v == %v, want %v", u, d.Since(u), u.AddPeriod(d.Since(u)), d)
+				}
+			}
+		}
+	}
+
+	if d, u := New(2023, time.January, 31), New(2023, time.February, 28); d.AddPeriod(d.Until(u)) != u {
+		t.Errorf("%v + %v == %v, want %v", d, d.Until(u), d.AddPeriod(d.Until(u)), u)
+	}
+}
+
+func TestDate_AddWeeks(t *testing.T) {
+	cases := []struct {
+		d     Date
+		weeks int
+		want  Date
+	}{
+		{d: New(2024, time.January, 1), weeks: 0, want: New(2024, time.January, 1)},
+		{d: New(2024, time.January, 1), weeks: 1, want: New(2024, time.January, 8)},
+		{d: New(2024, time.February, 26), weeks: 1, want: New(2024, time.March, 4)},
+		{d: New(2024, time.January, 1), weeks: 52, want: New(2024, time.December, 30)},
+		{d: New(2024, time.January, 1), weeks: -1, want: New(2023, time.December, 25)},
+		{d: New(1, time.January, 1), weeks: -53, want: New(-1, time.December, 27)},
+	}
+	for i, c := range cases {
+		r := c.d.AddWeeks(c.weeks)
+		if r != c.want {
+			t.Errorf("%d: %v.AddWeeks(%d) == %v, want %v", i, c.d, c.weeks, r, c.want)
+		}
+		if r != c.d.AddDays(7*c.weeks) || r.Weekday() != c.d.Weekday() {
+			t.Errorf("%d: %v.AddWeeks(%d) == %v is inconsistent", i, c.d, c.weeks, r)
+		}
+		if r.Truncate(UnitWeek) != c.d.Truncate(UnitWeek).AddWeeks(c.weeks) {
+			t.Errorf("%d: %v.AddWeeks(%d) does not compose with Truncate", i, c.d, c.weeks)
+		}
+	}
+}
+
+func TestDate_SameYear_SameMonth_SameISOWeek(t *testing.T) {
+	cases := []struct {
+		d, u                 Date
+		year, month, isoWeek bool
+	}{
+		{d: New(2024, time.May, 1), u: New(2024, time.May, 1), year: true, month: true, isoWeek: true},
+		{d: New(2024, time.May, 1), u: New(2024, time.May, 31), year: true, month: true},
+		{d: New(2024, time.May, 1), u: New(2023, time.May, 1)},
+		{d: New(2024, time.May, 31), u: New(2024, time.June, 1), year: true, isoWeek: true},
+		// ISO week 1 of 2025 starts on Monday 30th December 2024
+		{d: New(2024, time.December, 30), u: New(2025, time.January, 5), isoWeek: true},
+		{d: New(2024, time.December, 29), u: New(2024, time.December, 30), year: true, month: true},
+		// ISO week 53 of 2020 ends on Sunday 3rd January 2021
+		{d: New(2020, time.December, 28), u: New(2021, time.January, 3), isoWeek: true},
+		{d: New(2021, time.January, 3), u: New(2021, time.January, 4), year: true, month: true},
+		{d: New(-1, time.March, 1), u: New(-1, time.March, 2), year: true, month: true, isoWeek: true},
+	}
+	for i, c := range cases {
+		for _, p := range [][2]Date{{c.d, c.u}, {c.u, c.d}} {
+			if p[0].SameYear(p[1]) != c.year || p[0].SameMonth(p[1]) != c.month || p[0].SameISOWeek(p[1]) != c.isoWeek {
+				t.Errorf("%d: %v and %v gave %v, %v, %v", i, p[0], p[1], p[0].SameYear(p[1]), p[0].SameMonth(p[1]), p[0].SameISOWeek(p[1]))
+			}
+		}
+	}
+}
+
+func TestDate_AddClock(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	d := New(2024, time.March, 31) // DST starts in Berlin
+
+	cases := []struct {
+		c    clock.Clock
+		want time.Time
+	}{
+		{c: clock.Midnight, want: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{c: clock.New(14, 30, 5, 0), want: time.Date(2024, 3, 31, 14, 30, 5, 0, time.UTC)},
+		{c: clock.Day, want: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{c: clock.New(25, 0, 0, 0), want: time.Date(2024, 4, 1, 1, 0, 0, 0, time.UTC)},
+		{c: clock.New(49, 30, 0, 0), want: time.Date(2024, 4, 2, 1, 30, 0, 0, time.UTC)},
+		{c: clock.New(-1, 0, 0, 0), want: time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC)},
+	}
+	for i, c := range cases {
+		r := d.AddClock(c.c)
+		if !r.Equal(c.want) || r.Location() != time.UTC {
+			t.Errorf("%d: %v.AddClock(%v) == %v, want %v", i, d, c.c, r, c.want)
+		}
+	}
+
+	r := d.AddClockIn(clock.New(25, 0, 0, 0), berlin)
+	if r.Year() != 2024 || r.Month() != time.April || r.Day() != 1 || r.Hour() != 2 {
+		t.Errorf("got %v", r)
+	}
+}
+
+func TestDate_BeforeTime_AfterTime(t *testing.T) {
+	plus2 := time.FixedZone("+02:00", 2*60*60)
+	d := New(2020, time.June, 15)
+
+	cases := []struct {
+		t             time.Time
+		before, after bool
+	}{
+		{t: time.Date(2020, 6, 14, 23, 59, 59, 999999999, time.UTC), before: false, after: true},
+		{t: time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC), before: false, after: false},
+		{t: time.Date(2020, 6, 15, 0, 0, 0, 1, time.UTC), before: true, after: false},
+		{t: time.Date(2020, 6, 15, 1, 30, 0, 0, plus2), before: false, after: true},
+		{t: time.Date(2020, 6, 15, 2, 0, 0, 0, plus2), before: false, after: false},
+		{t: time.Date(2020, 6, 15, 2, 30, 0, 0, plus2), before: true, after: false},
+	}
+	for i, c := range cases {
+		if d.BeforeTime(c.t) != c.before {
+			t.Errorf("%d: %v.BeforeTime(%v) == %v", i, d, c.t, !c.before)
+		}
+		if d.AfterTime(c.t) != c.after {
+			t.Errorf("%d: %v.AfterTime(%v) == %v", i, d, c.t, !c.after)
+		}
+	}
+}
+
+func BenchmarkDate_AddDays(b *testing.B) {
+	d := New(2000, time.January, 1)
+	for n := 0; n < b.N; n++ {
+		d = d.AddDays(1)
+	}
+}
+
+func BenchmarkDate_AddDate(b *testing.B) {
+	d := New(2000, time.January, 1)
+	for n := 0; n < b.N; n++ {
+		d = d.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package datetest
+
+import (
+	"time"
+
+	"github.com/rickb777/date/v2"
+	"github.com/rickb777/date/v2/clock"
+)
+
+// Freeze replaces date.Now so that the current time is always on date d in the local
+// time zone, keeping the time of day given by date.Now when Freeze is called. So
+// date.Today() returns d until the returned function is called to restore the previous
+// behaviour. It is intended to be used as
+//
+//	defer datetest.Freeze(date.New(2020, time.January, 2))()
+//
+// Because date.Now is a package variable, tests that freeze the time must not run in
+// parallel with other tests that use it.
+func Freeze(d date.Date) func() {
+	c := clock.NewAt(date.Now())
+	return setNow(d.Time(c, time.Local))
+}
+
+// FreezeClock replaces date.Now so that the current time is always the clock time c in
+// the local time zone, on the date given by date.Today() when FreezeClock is called. It
+// can be combined with Freeze to fix both the date and the time of day. The returned
+// function restores the previous behaviour. The same restrictions apply as for Freeze.
+func FreezeClock(c clock.Clock) func() {
+	return setNow(date.Today().Time(c, time.Local))
+}
+
+func setNow(t time.Time) func() {
+	saved := date.Now
+	date.Now = func() time.Time { return t }
+	return func() { date.Now = saved }
+}
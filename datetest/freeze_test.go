@@ -0,0 +1,67 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package datetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rickb777/date/v2"
+	"github.com/rickb777/date/v2/clock"
+)
+
+func TestFreeze(t *testing.T) {
+	frozen := date.New(2000, time.February, 29)
+
+	restore := Freeze(frozen)
+	if d := date.Today(); d != frozen {
+		t.Errorf("got %v, want %v", d, frozen)
+	}
+	time.Sleep(time.Millisecond)
+	if d := date.Today(); d != frozen {
+		t.Errorf("got %v, want %v", d, frozen)
+	}
+
+	restore()
+	if d := date.Today(); d == frozen || d != date.NewAt(time.Now()) {
+		t.Errorf("got %v after restore", d)
+	}
+}
+
+func TestFreezeClock(t *testing.T) {
+	frozen := date.New(2000, time.February, 29)
+	c := clock.New(13, 14, 15, 0)
+
+	restoreDate := Freeze(frozen)
+	restoreClock := FreezeClock(c)
+
+	now := date.Now()
+	if d := date.Today(); d != frozen {
+		t.Errorf("got %v, want %v", d, frozen)
+	}
+	if clock.NewAt(now) != c || now.Location() != time.Local {
+		t.Errorf("got %v", now)
+	}
+
+	// changing the date keeps the clock
+	restoreInner := Freeze(frozen + 1)
+	if d := date.Today(); d != frozen+1 {
+		t.Errorf("got %v, want %v", d, frozen+1)
+	}
+	if clock.NewAt(date.Now()) != c {
+		t.Errorf("got %v", date.Now())
+	}
+	restoreInner()
+
+	restoreClock()
+	if d := date.Today(); d != frozen {
+		t.Errorf("got %v, want %v", d, frozen)
+	}
+
+	restoreDate()
+	if d := date.Today(); d == frozen {
+		t.Errorf("got %v after restore", d)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package datetest provides helpers for testing code that uses dates, such as
+// generators of random dates for property tests and ways to freeze the current date.
+package datetest
+
+import (
+	"math/rand"
+
+	"github.com/rickb777/date/v2"
+)
+
+// RandomDate returns a uniformly-random date in the range [min, max], inclusive of both,
+// using the given source of randomness. Using a rand.Rand with a fixed seed gives a
+// reproducible sequence of dates. It panics if max is before min.
+func RandomDate(rng *rand.Rand, min, max date.Date) date.Date {
+	if max < min {
+		panic("datetest.RandomDate: max is before min")
+	}
+	return min + date.Date(rng.Int63n(int64(max-min)+1))
+}
@@ -0,0 +1,60 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package datetest
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/rickb777/date/v2"
+)
+
+func TestRandomDate_bounds(t *testing.T) {
+	cases := []struct {
+		min, max date.Date
+	}{
+		{min: date.New(2020, time.January, 1), max: date.New(2020, time.December, 31)},
+		{min: date.New(-100, time.January, 1), max: date.New(100, time.January, 1)},
+		{min: date.New(2020, time.March, 1), max: date.New(2020, time.March, 3)},
+		{min: date.New(2020, time.March, 1), max: date.New(2020, time.March, 1)},
+		{min: date.MinDate, max: date.MaxDate},
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i, c := range cases {
+		seen := make(map[date.Date]bool)
+		for n := 0; n < 1000; n++ {
+			d := RandomDate(rng, c.min, c.max)
+			if d < c.min || d > c.max {
+				t.Fatalf("%d: %v is outside [%v, %v]", i, d, c.min, c.max)
+			}
+			seen[d] = true
+		}
+		if span := int64(c.max-c.min) + 1; span <= 3 && int64(len(seen)) != span {
+			t.Errorf("%d: only saw %d of %d dates", i, len(seen), span)
+		}
+	}
+}
+
+func TestRandomDate_reproducible(t *testing.T) {
+	min, max := date.New(1900, time.January, 1), date.New(2100, time.January, 1)
+	r1 := rand.New(rand.NewSource(42))
+	r2 := rand.New(rand.NewSource(42))
+	for n := 0; n < 100; n++ {
+		d1, d2 := RandomDate(r1, min, max), RandomDate(r2, min, max)
+		if d1 != d2 {
+			t.Fatalf("%d: %v != %v", n, d1, d2)
+		}
+	}
+}
+
+func TestRandomDate_panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+	RandomDate(rand.New(rand.NewSource(1)), date.New(2020, time.January, 2), date.New(2020, time.January, 1))
+}
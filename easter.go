@@ -0,0 +1,30 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// Easter returns the date of Easter Sunday in the given year, using the Gregorian
+// computus (the "Anonymous Gregorian algorithm", also known as the Meeus/Jones/Butcher
+// algorithm). Good Friday and Easter Monday are two days before and one day after.
+//
+// The result is only meaningful for years since the Gregorian reform in 1582.
+func Easter(year int) Date {
+	a := mod(year, 19)
+	b := floorDiv(year, 100)
+	c := mod(year, 100)
+	d := floorDiv(b, 4)
+	e := mod(b, 4)
+	f := floorDiv(b+8, 25)
+	g := floorDiv(b-f+1, 3)
+	h := mod(19*a+b-d-g+15, 30)
+	i := c / 4
+	k := c % 4
+	l := mod(32+2*e+2*i-h-k, 7)
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return New(year, time.Month(month), day)
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	// published dates of Easter Sunday, 2000 to 2030
+	table := []string{
+		"2000-04-23", "2001-04-15", "2002-03-31", "2003-04-20", "2004-04-11",
+		"2005-03-27", "2006-04-16", "2007-04-08", "2008-03-23", "2009-04-12",
+		"2010-04-04", "2011-04-24", "2012-04-08", "2013-03-31", "2014-04-20",
+		"2015-04-05", "2016-03-27", "2017-04-16", "2018-04-01", "2019-04-21",
+		"2020-04-12", "2021-04-04", "2022-04-17", "2023-04-09", "2024-03-31",
+		"2025-04-20", "2026-04-05", "2027-03-28", "2028-04-16", "2029-04-01",
+		"2030-04-21",
+	}
+	for i, s := range table {
+		year := 2000 + i
+		want := MustParseISO(s)
+		if e := Easter(year); e != want {
+			t.Errorf("Easter(%d) == %v, want %v", year, e, want)
+		}
+	}
+
+	// the extremes, and some earlier years
+	others := []struct {
+		year int
+		want Date
+	}{
+		{year: 1818, want: New(1818, time.March, 22)},
+		{year: 1943, want: New(1943, time.April, 25)},
+		{year: 1961, want: New(1961, time.April, 2)},
+		{year: 2285, want: New(2285, time.March, 22)},
+	}
+	for i, c := range others {
+		if e := Easter(c.year); e != c.want {
+			t.Errorf("%d: Easter(%d) == %v, want %v", i, c.year, e, c.want)
+		}
+	}
+
+	for year := 1583; year < 3000; year++ {
+		e := Easter(year)
+		if e.Weekday() != time.Sunday || e < New(year, time.March, 22) || e > New(year, time.April, 25) {
+			t.Fatalf("Easter(%d) == %v %v", year, e, e.Weekday())
+		}
+	}
+}
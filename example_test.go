@@ -94,3 +94,11 @@ func ExampleDate_FormatISO() {
 	fmt.Println(d.FormatISO(5))
 	// Output: -00752-04-21
 }
+
+func ExampleDate_FormatOrdinalISO() {
+	// The Battle of Hastings was fought on 14th October 1066 (Julian calendar),
+	// which is 20th October 1066 in the proleptic Gregorian calendar.
+	d := New(1066, time.October, 20)
+	fmt.Println(d.FormatOrdinalISO(5))
+	// Output: +01066-293
+}
@@ -32,38 +32,87 @@ const (
 	RFC3339  = "2006-01-02"
 )
 
+// These are the date-only parts of the corresponding layouts in the time package,
+// i.e. with the time of day and zone removed.
+const (
+	RFC822Date  = "02 Jan 06"        // date part of time.RFC822
+	RFC1123Date = "Mon, 02 Jan 2006" // date part of time.RFC1123; the same as RFC1123W
+	ANSICDate   = "Mon Jan _2 2006"  // date part of time.ANSIC
+)
+
 // String returns the time formatted in ISO 8601 extended format
 // (e.g. "2006-01-02").  If the year of the date falls outside the
 // [0,9999] range, this format produces an expanded year representation
 // with possibly extra year digits beyond the prescribed four-digit minimum
 // and with a + or - sign prefix (e.g. , "+12345-06-07", "-0987-06-05").
 func (d Date) String() string {
-	buf := &strings.Builder{}
-	buf.Grow(12)
-	d.WriteTo(buf)
-	return buf.String()
+	var buf [16]byte
+	return string(d.AppendISO(buf[:0]))
 }
 
-// WriteTo is as per String, albeit writing to an io.Writer.
+// WriteTo is as per String, albeit writing to an io.Writer. It implements io.WriterTo.
+// No intermediate string is created, so this is efficient for streaming many dates,
+// e.g. into a bufio.Writer.
 func (d Date) WriteTo(w io.Writer) (n64 int64, err error) {
-	var n int
+	var buf [16]byte
+	n, err := w.Write(d.AppendISO(buf[:0]))
+	return int64(n), err
+}
+
+// AppendISO is as per String, albeit appending to a byte slice and returning the
+// extended slice. The result is the same as from MarshalText.
+func (d Date) AppendISO(b []byte) []byte {
 	year, month, day := d.Date()
-	if 0 <= year && year < 10000 {
-		n, err = fmt.Fprintf(w, "%04d-%02d-%02d", year, month, day)
-	} else {
-		n, err = fmt.Fprintf(w, "%+05d-%02d-%02d", year, month, day)
+	if year > 9999 {
+		b = append(b, '+')
 	}
-	return int64(n), err
+	b = appendInt(b, year, 4)
+	b = append(b, '-')
+	b = appendInt(b, int(month), 2)
+	b = append(b, '-')
+	return appendInt(b, day, 2)
+}
+
+// RFC3339 returns the date formatted as an RFC 3339 full-date, which is exactly
+// "YYYY-MM-DD" (e.g. "2006-01-02"). Unlike String, no sign or expanded year is ever
+// used, so an error is returned if the year of the date falls outside the [0,9999] range.
+func (d Date) RFC3339() (string, error) {
+	year, month, day := d.Date()
+	if year < 0 || year > 9999 {
+		return "", fmt.Errorf("date.RFC3339: year %d is outside the range [0,9999]", year)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", year, month, day), nil
+}
+
+// FormatYMD returns the date as "YYYY-MM-DD" but with the given separator between the
+// fields instead of '-', e.g. FormatYMD('/') gives "2006/01/02". As with RFC3339, the year
+// always has exactly four digits and no sign, which suits uses such as generating file
+// names, so an error is returned if the year falls outside the [0,9999] range.
+func (d Date) FormatYMD(sep byte) (string, error) {
+	year, month, day := d.Date()
+	if year < 0 || year > 9999 {
+		return "", fmt.Errorf("date.FormatYMD: year %d is outside the range [0,9999]", year)
+	}
+	var buf [10]byte
+	b := appendInt(buf[:0], year, 4)
+	b = append(b, sep)
+	b = appendInt(b, int(month), 2)
+	b = append(b, sep)
+	return string(appendInt(b, day, 2)), nil
 }
 
 // FormatOrdinal returns a textual representation of the date value formatted
 // according to the ordinal date variant of the ISO 8601 format.
-// The year of the date is represented as a signed integer. The three-digit
-// ordinal day number is appended.
+// The year of the date is represented as a signed integer of at least four digits
+// (e.g. "-0752-112"). The three-digit ordinal day number is appended.
 func (d Date) FormatOrdinal() string {
 	t := decode(d)
 	year := t.Year()
 	ordinal := d.YearDay()
+	if year < 0 {
+		// the sign attaches to the year, which still has at least four digits
+		return fmt.Sprintf("%05d-%03d", year, ordinal)
+	}
 	return fmt.Sprintf("%04d-%03d", year, ordinal)
 }
 
@@ -86,6 +135,21 @@ func (d Date) FormatISO(yearDigits int) string {
 	return fmt.Sprintf("%+0*d-%02d-%02d", n, year, month, day)
 }
 
+// FormatOrdinalISO returns a textual representation of the date value formatted
+// according to the expanded year variant of the ISO 8601 ordinal date format
+// (e.g. "+2006-217"). As per FormatISO, the year of the date is represented as a
+// signed integer using the specified number of digits (ignored if less than four).
+// The three-digit ordinal day number is appended.
+//
+// The result can be parsed using ParseISO.
+func (d Date) FormatOrdinalISO(yearDigits int) string {
+	n := 5 // four-digit minimum plus sign
+	if yearDigits > 4 {
+		n += yearDigits - 4
+	}
+	return fmt.Sprintf("%+0*d-%03d", n, d.Year(), d.YearDay())
+}
+
 // Format returns a textual representation of the date value formatted according
 // to layout, which defines the format by showing how the reference date,
 // defined to be
@@ -108,43 +172,72 @@ func (d Date) FormatISO(yearDigits int) string {
 // the suffix strings for a different locale, change DaySuffixes or use FormatWithSuffixes
 // instead.
 //
+// ISO 8601 week dates are also supported, using placeholders that time layouts
+// lack: "<weekyear>" is the ISO week-numbering year (at least four digits), "<week>" is
+// the two-digit ISO week number and "<weekday>" is the ISO day of the week, from 1 for
+// Monday to 7 for Sunday. For example, "<weekyear>-W<week>-<weekday>" gives "2020-W53-5"
+// for 1st January 2021. Note that near the start and end of the year, the week-numbering
+// year can differ from the calendar year given by "2006".
+//
 // This function cannot currently format Date values according to the expanded
 // year variant of ISO 8601; you should use Date.FormatISO to that effect.
 func (d Date) Format(layout string) string {
 	return d.FormatWithSuffixes(layout, DaySuffixes)
 }
 
+// FormatRFC1123 returns a textual representation of the date using the RFC1123Date
+// layout, e.g. "Mon, 02 Jan 2006". This is shorthand for d.Format(RFC1123Date).
+func (d Date) FormatRFC1123() string {
+	return d.Format(RFC1123Date)
+}
+
 // FormatWithSuffixes is the same as Format, except the suffix strings can be specified
 // explicitly, which allows multiple locales to be supported. The suffixes slice should
 // contain 31 strings covering the days 1 (index 0) to 31 (index 30).
 func (d Date) FormatWithSuffixes(layout string, suffixes []string) string {
+	return string(d.appendFormat(make([]byte, 0, len(layout)+10), layout, suffixes))
+}
+
+// AppendFormat is like Format but appends the textual representation to b and returns
+// the extended buffer, as per time.Time.AppendFormat. This allows a buffer to be reused
+// when formatting many dates. Day-number suffixes are supported as per Format.
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+	return d.appendFormat(b, layout, DaySuffixes)
+}
+
+func (d Date) appendFormat(b []byte, layout string, suffixes []string) []byte {
 	t := decode(d)
-	parts := strings.Split(layout, "nd")
-	switch len(parts) {
-	case 1:
-		return t.Format(layout)
-
-	default:
-		// If the format contains "Monday", it has been split so repair it.
-		i := 1
-		for i < len(parts) {
-			if i > 0 && strings.HasSuffix(parts[i-1], "Mo") && strings.HasPrefix(parts[i], "ay") {
-				parts[i-1] = parts[i-1] + "nd" + parts[i]
-				copy(parts[i:], parts[i+1:])
-				parts = parts[:len(parts)-1]
-			} else {
-				i++
-			}
-		}
-		a := make([]string, 0, 2*len(parts)-1)
-		for i, p := range parts {
+	for layout != "" {
+		prefix, token, rest := nextWeekToken(layout)
+		for i, p := range splitAtSuffixes(prefix) {
 			if i > 0 {
-				a = append(a, suffixes[d.Day()-1])
+				b = append(b, suffixes[d.Day()-1]...)
 			}
-			a = append(a, t.Format(p))
+			b = t.AppendFormat(b, p)
+		}
+		if token != "" {
+			b = appendWeekToken(b, weekTokens[token], d)
+		}
+		layout = rest
+	}
+	return b
+}
+
+// splitAtSuffixes splits a layout at each "nd" that marks the place of a day-number suffix.
+func splitAtSuffixes(layout string) []string {
+	parts := strings.Split(layout, "nd")
+	// If the format contains "Monday", it has been split so repair it.
+	i := 1
+	for i < len(parts) {
+		if strings.HasSuffix(parts[i-1], "Mo") && strings.HasPrefix(parts[i], "ay") {
+			parts[i-1] = parts[i-1] + "nd" + parts[i]
+			copy(parts[i:], parts[i+1:])
+			parts = parts[:len(parts)-1]
+		} else {
+			i++
 		}
-		return strings.Join(a, "")
 	}
+	return parts
 }
 
 // DaySuffixes is the default array of strings used as suffixes when a format string
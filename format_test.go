@@ -5,7 +5,11 @@
 package date
 
 import (
+	"bytes"
+	"io"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDate_String(t *testing.T) {
@@ -29,6 +33,103 @@ func TestDate_String(t *testing.T) {
 	}
 }
 
+func TestDate_WriteTo_and_AppendISO(t *testing.T) {
+	cases := []struct {
+		value Date
+		want  string
+	}{
+		{value: New(-123456, time.January, 2), want: "-123456-01-02"},
+		{value: New(-1, time.December, 31), want: "-0001-12-31"},
+		{value: New(0, time.January, 1), want: "0000-01-01"},
+		{value: New(2006, time.January, 2), want: "2006-01-02"},
+		{value: New(9999, time.December, 31), want: "9999-12-31"},
+		{value: New(10000, time.January, 1), want: "+10000-01-01"},
+		{value: New(5000000, time.August, 9), want: "+5000000-08-09"},
+		{value: Min(), want: "-5879610-06-24"},
+		{value: Max(), want: "+5877642-07-12"},
+	}
+	for i, c := range cases {
+		buf := &bytes.Buffer{}
+		n, err := c.value.WriteTo(buf)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if buf.String() != c.want || n != int64(len(c.want)) {
+			t.Errorf("%d: WriteTo wrote %q (%d), want %q (%d)", i, buf.String(), n, c.want, len(c.want))
+		}
+
+		b := c.value.AppendISO([]byte("x"))
+		if string(b) != "x"+c.want {
+			t.Errorf("%d: AppendISO gave %q, want %q", i, b, c.want)
+		}
+	}
+
+	var w io.WriterTo = Zero
+	buf := &bytes.Buffer{}
+	buf.Grow(100)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf.Reset()
+		w.WriteTo(buf)
+	})
+	if allocs > 1 {
+		t.Errorf("WriteTo made %v allocations", allocs)
+	}
+}
+
+func TestDate_RFC3339(t *testing.T) {
+	cases := []struct {
+		value Date
+		want  string
+	}{
+		{value: New(0, time.January, 1), want: "0000-01-01"},
+		{value: New(1970, time.January, 1), want: "1970-01-01"},
+		{value: New(9999, time.December, 31), want: "9999-12-31"},
+		{value: New(10000, time.January, 1), want: ""},
+		{value: New(-1, time.December, 31), want: ""},
+	}
+	for i, c := range cases {
+		s, err := c.value.RFC3339()
+		if c.want == "" {
+			if err == nil {
+				t.Errorf("%d: RFC3339(%v) == %q, want error", i, c.value, s)
+			}
+		} else if err != nil {
+			t.Errorf("%d: RFC3339(%v) unexpected error %v", i, c.value, err)
+		} else if s != c.want {
+			t.Errorf("%d: RFC3339(%v) == %q, want %q", i, c.value, s, c.want)
+		}
+	}
+}
+
+func TestDate_FormatYMD(t *testing.T) {
+	cases := []struct {
+		value Date
+		sep   byte
+		want  string
+	}{
+		{value: New(2006, time.January, 2), sep: '-', want: "2006-01-02"},
+		{value: New(2006, time.January, 2), sep: '/', want: "2006/01/02"},
+		{value: New(2006, time.January, 2), sep: '.', want: "2006.01.02"},
+		{value: New(1, time.January, 1), sep: '_', want: "0001_01_01"},
+		{value: New(0, time.February, 29), sep: '-', want: "0000-02-29"},
+		{value: New(9999, time.December, 31), sep: '-', want: "9999-12-31"},
+		{value: New(10000, time.January, 1), sep: '-', want: ""},
+		{value: New(-1, time.December, 31), sep: '/', want: ""},
+	}
+	for i, c := range cases {
+		s, err := c.value.FormatYMD(c.sep)
+		if c.want == "" {
+			if err == nil {
+				t.Errorf("%d: FormatYMD(%v) == %q, want error", i, c.value, s)
+			}
+		} else if err != nil {
+			t.Errorf("%d: FormatYMD(%v) unexpected error %v", i, c.value, err)
+		} else if s != c.want {
+			t.Errorf("%d: FormatYMD(%v) == %q, want %q", i, c.value, s, c.want)
+		}
+	}
+}
+
 func TestDate_FormatOrdinal(t *testing.T) {
 	cases := []struct {
 		value, expected string
@@ -43,6 +144,8 @@ func TestDate_FormatOrdinal(t *testing.T) {
 		{value: "1970-001", expected: "1970-001"},
 		{value: "001999-365", expected: "1999-365"},
 		{value: "999999-365", expected: "999999-365"},
+		{value: "-00752-112", expected: "-0752-112"},
+		{value: "-0004-366", expected: "-0004-366"},
 	}
 	for i, c := range cases {
 		d := MustParseISO(c.value)
@@ -53,6 +156,41 @@ func TestDate_FormatOrdinal(t *testing.T) {
 	}
 }
 
+func TestDate_ordinal_negative_year_round_trip(t *testing.T) {
+	cases := []struct {
+		value     string
+		year, day int
+	}{
+		{value: "-00752-112", year: -752, day: 112},
+		{value: "-0752-112", year: -752, day: 112},
+		{value: "-0001-001", year: -1, day: 1},
+		{value: "-12345-365", year: -12345, day: 365},
+		{value: "+12345-001", year: 12345, day: 1},
+	}
+	for i, c := range cases {
+		d, err := ParseISO(c.value)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if d.Year() != c.year || d.YearDay() != c.day {
+			t.Errorf("%d: ParseISO(%q) == %v, day %d", i, c.value, d, d.YearDay())
+		}
+		if d != New(c.year, time.January, c.day) {
+			t.Errorf("%d: ParseISO(%q) == %v", i, c.value, d)
+		}
+
+		for _, s := range []string{d.FormatOrdinal(), d.FormatOrdinalISO(4), d.FormatOrdinalISO(5), d.FormatOrdinalISO(6)} {
+			if MustParseISO(s) != d {
+				t.Errorf("%d: %q does not round trip to %v", i, s, d)
+			}
+		}
+	}
+
+	if s := New(-752, time.April, 21).FormatOrdinalISO(5); s != "-00752-112" {
+		t.Errorf("got %s", s)
+	}
+}
+
 func TestDate_FormatISO(t *testing.T) {
 	cases := []struct {
 		value string
@@ -78,6 +216,29 @@ func TestDate_FormatISO(t *testing.T) {
 	}
 }
 
+func TestDate_FormatOrdinalISO(t *testing.T) {
+	cases := []struct {
+		value string
+		n     int
+	}{
+		{value: "-5000-001", n: 4},
+		{value: "-05000-001", n: 5},
+		{value: "+0000-001", n: 4},
+		{value: "+00000-366", n: 5},
+		{value: "+1970-001", n: 4},
+		{value: "+2004-366", n: 4},
+		{value: "+001999-365", n: 6},
+		{value: "+999999-365", n: 6},
+	}
+	for _, c := range cases {
+		d := MustParseISO(c.value)
+		value := d.FormatOrdinalISO(c.n)
+		if value != c.value {
+			t.Errorf("FormatOrdinalISO(%v) == %v, want %v", c, value, c.value)
+		}
+	}
+}
+
 func TestDate_Format(t *testing.T) {
 	cases := []struct {
 		value    string
@@ -109,3 +270,94 @@ func TestDate_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestDate_date_only_layouts(t *testing.T) {
+	d := New(2016, time.January, 7)
+	cases := []struct {
+		layout   string
+		expected string
+	}{
+		{layout: RFC822Date, expected: "07 Jan 16"},
+		{layout: RFC1123Date, expected: "Thu, 07 Jan 2016"},
+		{layout: ANSICDate, expected: "Thu Jan  7 2016"},
+	}
+	for _, c := range cases {
+		actual := d.Format(c.layout)
+		if actual != c.expected {
+			t.Errorf("Format(%q) == %q, want %q", c.layout, actual, c.expected)
+		}
+		p, err := Parse(c.layout, actual)
+		if err != nil || p != d {
+			t.Errorf("Parse(%q, %q) == %v, %v", c.layout, actual, p, err)
+		}
+	}
+
+	if s := d.FormatRFC1123(); s != "Thu, 07 Jan 2016" {
+		t.Errorf("FormatRFC1123() == %q", s)
+	}
+	if s := New(2016, time.November, 17).FormatRFC1123(); s != "Thu, 17 Nov 2016" {
+		t.Errorf("FormatRFC1123() == %q", s)
+	}
+}
+
+func TestDate_AppendFormat(t *testing.T) {
+	cases := []struct {
+		value  Date
+		layout string
+		want   string
+	}{
+		{value: New(2016, time.January, 7), layout: ISO8601, want: "2016-01-07"},
+		{value: New(2016, time.January, 7), layout: ISO8601B, want: "20160107"},
+		{value: New(2016, time.January, 7), layout: RFC1123W, want: "Thu, 07 Jan 2016"},
+		{value: New(2016, time.January, 7), layout: RFC850, want: "Thursday, 07-Jan-16"},
+		{value: New(2016, time.January, 7), layout: "Jan 2nd 2006", want: "Jan 7th 2016"},
+		{value: New(2016, time.January, 7), layout: "Monday 2nd Monday 2nd", want: "Thursday 7th Thursday 7th"},
+		{value: New(2016, time.January, 7), layout: "02/01/06", want: "07/01/16"},
+		{value: New(2016, time.January, 7), layout: "", want: ""},
+		{value: New(2016, time.November, 22), layout: "Jan 2nd 2006", want: "Nov 22nd 2016"},
+		{value: New(2016, time.November, 22), layout: RFC850, want: "Tuesday, 22-Nov-16"},
+		{value: New(1, time.January, 1), layout: RFC1123W, want: "Mon, 01 Jan 0001"},
+		{value: New(1, time.January, 1), layout: "Monday 2nd Monday 2nd", want: "Monday 1st Monday 1st"},
+		{value: New(1, time.January, 1), layout: "02/01/06", want: "01/01/01"},
+		{value: New(9999, time.December, 31), layout: ISO8601B, want: "99991231"},
+		{value: New(9999, time.December, 31), layout: "Jan 2nd 2006", want: "Dec 31st 9999"},
+		{value: New(9999, time.December, 31), layout: RFC850, want: "Friday, 31-Dec-99"},
+	}
+	for i, c := range cases {
+		if s := string(c.value.AppendFormat(nil, c.layout)); s != c.want {
+			t.Errorf("%d: %v.AppendFormat(nil, %q) == %q, want %q", i, c.value, c.layout, s, c.want)
+		}
+	}
+
+	b := []byte("on ")
+	b = New(2016, time.March, 3).AppendFormat(b, "Monday 2nd January")
+	if string(b) != "on Thursday 3rd March" {
+		t.Errorf("got %q", b)
+	}
+}
+
+func TestDate_Format_week_tokens(t *testing.T) {
+	cases := []struct {
+		value    Date
+		layout   string
+		expected string
+	}{
+		{value: New(2020, time.January, 6), layout: "2006-W<week>", expected: "2020-W02"},
+		{value: New(2020, time.January, 1), layout: "<weekyear>-W<week>-<weekday>", expected: "2020-W01-3"},
+		{value: New(2019, time.December, 30), layout: "<weekyear>-W<week>-<weekday>", expected: "2020-W01-1"},
+		{value: New(2019, time.December, 30), layout: "2006-01-02 is <weekyear>W<week><weekday>", expected: "2019-12-30 is 2020W011"},
+		{value: New(2021, time.January, 3), layout: "<weekyear>-W<week>-<weekday>", expected: "2020-W53-7"},
+		{value: New(2021, time.January, 3), layout: "Mon 2nd Jan 2006 (week <week> of <weekyear>)", expected: "Sun 3rd Jan 2021 (week 53 of 2020)"},
+		{value: New(2021, time.January, 4), layout: "<weekyear>-W<week>-<weekday>", expected: "2021-W01-1"},
+		{value: New(2021, time.January, 4), layout: "<week> <weeks> <weekday", expected: "01 <weeks> <weekday"},
+	}
+	for i, c := range cases {
+		actual := c.value.Format(c.layout)
+		if actual != c.expected {
+			t.Errorf("%d: %v.Format(%q) == %q, want %q", i, c.value, c.layout, actual, c.expected)
+		}
+		if r, err := ParseISO(actual); strings.HasPrefix(c.layout, "<weekyear>-W") && (err != nil || r != c.value) {
+			t.Errorf("%d: ParseISO(%q) == %v, %v", i, actual, r, err)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"strings"
+	"time"
+)
+
+// Formatter formats dates using a layout that is analysed once, when the Formatter is
+// created, rather than every time a date is formatted. This makes it faster than Date.Format
+// when the same layout is used repeatedly, especially for layouts containing a day-number
+// suffix. As measured by the Formatter benchmarks on amd64, "Monday, January 2nd, 2006"
+// is about five times faster (160ns versus 800ns per date, with no allocations versus
+// three) and RFC1123W is about two to three times faster (120ns versus 260-340ns).
+//
+// A Formatter is immutable, so it is safe for concurrent use by multiple goroutines.
+type Formatter struct {
+	chunks   []chunk
+	suffixes []string
+}
+
+// chunk is either literal text or one of the date-dependent layout elements.
+type chunk struct {
+	kind chunkKind
+	lit  string
+}
+
+type chunkKind int
+
+const (
+	literal chunkKind = iota
+	longYear
+	shortYear
+	longMonth
+	shortMonth
+	numMonth
+	zeroMonth
+	longWeekDay
+	shortWeekDay
+	numDay
+	underDay
+	zeroDay
+	underYearDay
+	zeroYearDay
+	daySuffix
+	isoWeekYear
+	isoWeek
+	isoWeekday
+)
+
+// weekTokens are the placeholders for the ISO 8601 week date fields, which the time
+// package's layouts cannot express.
+var weekTokens = map[string]chunkKind{
+	"<weekyear>": isoWeekYear,
+	"<week>":     isoWeek,
+	"<weekday>":  isoWeekday,
+}
+
+// nextWeekToken finds the first week date placeholder in the layout, returning the text
+// before it, the placeholder itself and the text after it. If there is no placeholder,
+// the whole layout is the prefix and the token is blank.
+func nextWeekToken(layout string) (prefix, token, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		if layout[i] == '<' {
+			for tok := range weekTokens {
+				if strings.HasPrefix(layout[i:], tok) {
+					return layout[:i], tok, layout[i+len(tok):]
+				}
+			}
+		}
+	}
+	return layout, "", ""
+}
+
+// appendWeekToken appends the value of a week date placeholder for date d.
+func appendWeekToken(b []byte, kind chunkKind, d Date) []byte {
+	year, week := d.ISOWeek()
+	switch kind {
+	case isoWeekYear:
+		return appendInt(b, year, 4)
+	case isoWeek:
+		return appendInt(b, week, 2)
+	}
+	return appendInt(b, d.ISOWeekday(), 1)
+}
+
+var chunkKinds = map[string]chunkKind{
+	"2006":    longYear,
+	"06":      shortYear,
+	"January": longMonth,
+	"Jan":     shortMonth,
+	"1":       numMonth,
+	"01":      zeroMonth,
+	"Monday":  longWeekDay,
+	"Mon":     shortWeekDay,
+	"2":       numDay,
+	"_2":      underDay,
+	"02":      zeroDay,
+	"__2":     underYearDay,
+	"002":     zeroYearDay,
+}
+
+// NewFormatter returns a Formatter for the given layout, which is interpreted exactly
+// as by Date.Format. The day-number suffixes are those in DaySuffixes at the time
+// NewFormatter is called.
+func NewFormatter(layout string) Formatter {
+	return NewFormatterWithSuffixes(layout, DaySuffixes)
+}
+
+// NewFormatterWithSuffixes is the same as NewFormatter, except the suffix strings can be
+// specified explicitly, as per Date.FormatWithSuffixes.
+func NewFormatterWithSuffixes(layout string, suffixes []string) Formatter {
+	var chunks []chunk
+	for layout != "" {
+		prefix, token, rest := nextWeekToken(layout)
+		for i, part := range splitAtSuffixes(prefix) {
+			if i > 0 {
+				chunks = append(chunks, chunk{kind: daySuffix})
+			}
+			chunks = compileLayout(chunks, part)
+		}
+		if token != "" {
+			chunks = append(chunks, chunk{kind: weekTokens[token]})
+		}
+		layout = rest
+	}
+	return Formatter{chunks: chunks, suffixes: append([]string(nil), suffixes...)}
+}
+
+// compileLayout splits the layout into its elements. The elements that don't depend on
+// the date (such as "15" for the hour) are formatted once here, because a Date is always
+// treated as midnight UTC.
+func compileLayout(chunks []chunk, layout string) []chunk {
+	for layout != "" {
+		prefix, std, suffix := nextStdChunk(layout)
+		if prefix != "" {
+			chunks = append(chunks, chunk{lit: prefix})
+		}
+		if std != "" {
+			if kind, ok := chunkKinds[std]; ok {
+				chunks = append(chunks, chunk{kind: kind})
+			} else {
+				chunks = append(chunks, chunk{lit: time.Time{}.Format(std)})
+			}
+		}
+		layout = suffix
+	}
+	return chunks
+}
+
+// Format returns a textual representation of the date value, the same as Date.Format
+// would give for the Formatter's layout.
+func (f Formatter) Format(d Date) string {
+	return string(f.appendFormat(make([]byte, 0, 32), d))
+}
+
+func (f Formatter) appendFormat(b []byte, d Date) []byte {
+	t := decode(d)
+	year, month, day := t.Date()
+
+	for _, c := range f.chunks {
+		switch c.kind {
+		case literal:
+			b = append(b, c.lit...)
+		case longYear:
+			b = appendInt(b, year, 4)
+		case shortYear:
+			b = appendInt(b, abs(year)%100, 2)
+		case longMonth:
+			b = append(b, month.String()...)
+		case shortMonth:
+			b = append(b, month.String()[:3]...)
+		case numMonth:
+			b = appendInt(b, int(month), 0)
+		case zeroMonth:
+			b = appendInt(b, int(month), 2)
+		case longWeekDay:
+			b = append(b, t.Weekday().String()...)
+		case shortWeekDay:
+			b = append(b, t.Weekday().String()[:3]...)
+		case numDay:
+			b = appendInt(b, day, 0)
+		case underDay:
+			if day < 10 {
+				b = append(b, ' ')
+			}
+			b = appendInt(b, day, 0)
+		case zeroDay:
+			b = appendInt(b, day, 2)
+		case underYearDay:
+			yday := t.YearDay()
+			if yday < 100 {
+				b = append(b, ' ')
+				if yday < 10 {
+					b = append(b, ' ')
+				}
+			}
+			b = appendInt(b, yday, 0)
+		case zeroYearDay:
+			b = appendInt(b, t.YearDay(), 3)
+		case daySuffix:
+			b = append(b, f.suffixes[day-1]...)
+		case isoWeekYear, isoWeek, isoWeekday:
+			b = appendWeekToken(b, c.kind, d)
+		}
+	}
+	return b
+}
+
+// appendInt appends the decimal form of x to b, padded with leading zeros to the
+// given width, in the same way as the time package.
+func appendInt(b []byte, x int, width int) []byte {
+	u := uint64(x)
+	if x < 0 {
+		b = append(b, '-')
+		u = uint64(-x)
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+	}
+	i--
+	buf[i] = byte('0' + u)
+
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, '0')
+	}
+	return append(b, buf[i:]...)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// nextStdChunk finds the first layout element in layout, returning the text before it,
+// the element itself and the text after it. It recognises the same elements as the
+// time package; the Formatter tests compare it with time.Time.Format for every element,
+// so they will fail if the time package gains an element that is missing here.
+func nextStdChunk(layout string) (prefix, std, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		n := 0
+		switch layout[i] {
+		case 'J': // January, Jan
+			if hasPrefixAt(layout, i, "January") {
+				n = 7
+			} else if hasPrefixAt(layout, i, "Jan") && !startsWithLowerCase(layout[i+3:]) {
+				n = 3
+			}
+
+		case 'M': // Monday, Mon, MST
+			if hasPrefixAt(layout, i, "Monday") {
+				n = 6
+			} else if hasPrefixAt(layout, i, "Mon") && !startsWithLowerCase(layout[i+3:]) {
+				n = 3
+			} else if hasPrefixAt(layout, i, "MST") {
+				n = 3
+			}
+
+		case '0': // 01, 02, 03, 04, 05, 06, 002
+			if i+1 < len(layout) && '1' <= layout[i+1] && layout[i+1] <= '6' {
+				n = 2
+			} else if hasPrefixAt(layout, i, "002") {
+				n = 3
+			}
+
+		case '1': // 15, 1
+			if hasPrefixAt(layout, i, "15") {
+				n = 2
+			} else {
+				n = 1
+			}
+
+		case '2': // 2006, 2
+			if hasPrefixAt(layout, i, "2006") {
+				n = 4
+			} else {
+				n = 1
+			}
+
+		case '_': // _2, _2006, __2
+			if hasPrefixAt(layout, i, "_2006") {
+				// _2006 is really a literal _, followed by 2006
+				return layout[:i+1], "2006", layout[i+5:]
+			} else if hasPrefixAt(layout, i, "_2") {
+				n = 2
+			} else if hasPrefixAt(layout, i, "__2") {
+				n = 3
+			}
+
+		case '3', '4', '5':
+			n = 1
+
+		case 'P', 'p': // PM, pm
+			if hasPrefixAt(layout, i, "PM") || hasPrefixAt(layout, i, "pm") {
+				n = 2
+			}
+
+		case '-', 'Z': // -070000, -07:00:00, -0700, -07:00, -07 and likewise with Z
+			for _, tz := range []string{"070000", "07:00:00", "0700", "07:00", "07"} {
+				if hasPrefixAt(layout, i+1, tz) {
+					n = 1 + len(tz)
+					break
+				}
+			}
+
+		case '.', ',': // .000, ,000, .999 or ,999 - repeated digits for fractional seconds
+			if i+1 < len(layout) && (layout[i+1] == '0' || layout[i+1] == '9') {
+				j := i + 1
+				for j < len(layout) && layout[j] == layout[i+1] {
+					j++
+				}
+				// the string of digits must end here
+				if j == len(layout) || layout[j] < '0' || layout[j] > '9' {
+					n = j - i
+				}
+			}
+		}
+
+		if n > 0 {
+			return layout[:i], layout[i : i+n], layout[i+n:]
+		}
+	}
+	return layout, "", ""
+}
+
+func hasPrefixAt(s string, i int, prefix string) bool {
+	return len(s) >= i+len(prefix) && s[i:i+len(prefix)] == prefix
+}
+
+func startsWithLowerCase(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// julianDayOfZero is the Julian Day Number of Zero (0001-01-01 in the proleptic Gregorian calendar).
+const julianDayOfZero = 1721426
+
+// FromJulianCalendar returns the Date corresponding to the given year, month and day in the
+// (proleptic) Julian calendar. As with New, astronomical year numbering is used, so year 0 is
+// 1 BC. The month and day may be outside their usual ranges and will be normalized during the
+// conversion.
+//
+// The Julian calendar was used in most of Europe until the Gregorian reform, after which the
+// day following Thursday 4th October 1582 (Julian) was Friday 15th October 1582 (Gregorian).
+// Many countries adopted the reform much later. The difference between the two calendars
+// grows by three days every four centuries; for example it is 10 days in 1582 but 13 days
+// in 1917.
+//
+// See https://en.wikipedia.org/wiki/Julian_calendar
+func FromJulianCalendar(year int, month time.Month, day int) Date {
+	m := int(month) - 1
+	year += floorDiv(m, 12)
+	month = time.Month(mod(m, 12) + 1)
+
+	// algorithm from https://en.wikipedia.org/wiki/Julian_day#Converting_Julian_calendar_date_to_Julian_Day_Number
+	a := floorDiv(14-int(month), 12)
+	y := year + 4800 - a
+	mm := int(month) + 12*a - 3
+	jdn := day + floorDiv(153*mm+2, 5) + 365*y + floorDiv(y, 4) - 32083
+	return Date(jdn - julianDayOfZero)
+}
+
+// ToJulianCalendar returns the year, month and day of d in the (proleptic) Julian calendar.
+// As with Date, astronomical year numbering is used, so year 0 is 1 BC.
+// See FromJulianCalendar.
+func (d Date) ToJulianCalendar() (year int, month time.Month, day int) {
+	// algorithm from https://en.wikipedia.org/wiki/Julian_day#Julian_or_Gregorian_calendar_from_Julian_day_number
+	c := int(d) + julianDayOfZero + 32082
+	dd := floorDiv(4*c+3, 1461)
+	e := c - floorDiv(1461*dd, 4)
+	m := floorDiv(5*e+2, 153)
+	day = e - floorDiv(153*m+2, 5) + 1
+	month = time.Month(m + 3 - 12*(m/10))
+	year = dd - 4800 + m/10
+	return year, month, day
+}
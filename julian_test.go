@@ -0,0 +1,69 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestJulianCalendar(t *testing.T) {
+	cases := []struct {
+		jy        int
+		jm        time.Month
+		jd        int
+		gregorian Date
+	}{
+		// the Gregorian reform
+		{jy: 1582, jm: time.October, jd: 4, gregorian: New(1582, time.October, 14)},
+		{jy: 1582, jm: time.October, jd: 5, gregorian: New(1582, time.October, 15)},
+		// Great Britain switched in 1752
+		{jy: 1752, jm: time.September, jd: 2, gregorian: New(1752, time.September, 13)},
+		// the October Revolution
+		{jy: 1917, jm: time.October, jd: 25, gregorian: New(1917, time.November, 7)},
+		{jy: 2024, jm: time.February, jd: 29, gregorian: New(2024, time.March, 13)},
+		// the calendars coincide in the 3rd century
+		{jy: 200, jm: time.March, jd: 1, gregorian: New(200, time.March, 1)},
+		{jy: 300, jm: time.February, jd: 29, gregorian: New(300, time.March, 1)},
+		{jy: 1, jm: time.January, jd: 1, gregorian: New(0, time.December, 30)},
+		// Julian day 0 is 1st January 4713 BC (Julian)
+		{jy: -4712, jm: time.January, jd: 1, gregorian: New(-4713, time.November, 24)},
+		{jy: -10000, jm: time.March, jd: 1, gregorian: New(-10001, time.December, 15)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %04d-%02d-%02d", i, c.jy, c.jm, c.jd), func(t *testing.T) {
+			d := FromJulianCalendar(c.jy, c.jm, c.jd)
+			if d != c.gregorian {
+				t.Errorf("%d: FromJulianCalendar(%d, %d, %d) == %v, want %v", i, c.jy, c.jm, c.jd, d, c.gregorian)
+			}
+			y, m, dd := c.gregorian.ToJulianCalendar()
+			if y != c.jy || m != c.jm || dd != c.jd {
+				t.Errorf("%d: %v.ToJulianCalendar() == %04d-%02d-%02d", i, c.gregorian, y, m, dd)
+			}
+		})
+	}
+}
+
+func TestJulianCalendar_round_trip(t *testing.T) {
+	for d := New(-5000, time.January, 1); d < New(5000, time.January, 1); d += 97 {
+		y, m, dd := d.ToJulianCalendar()
+		if FromJulianCalendar(y, m, dd) != d {
+			t.Fatalf("%v -> %04d-%02d-%02d -> %v", d, y, m, dd, FromJulianCalendar(y, m, dd))
+		}
+	}
+}
+
+func TestFromJulianCalendar_normalises(t *testing.T) {
+	if FromJulianCalendar(1582, time.September, 35) != New(1582, time.October, 15) {
+		t.Errorf("got %v", FromJulianCalendar(1582, time.September, 35))
+	}
+	if FromJulianCalendar(1581, 22, 5) != New(1582, time.October, 15) {
+		t.Errorf("got %v", FromJulianCalendar(1581, 22, 5))
+	}
+	if FromJulianCalendar(1583, -2, 5) != New(1582, time.October, 15) {
+		t.Errorf("got %v", FromJulianCalendar(1583, -2, 5))
+	}
+}
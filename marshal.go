@@ -5,39 +5,70 @@
 package date
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
+	"sync/atomic"
 )
 
+// binaryVersion1 is the leading version byte of the current binary format, which is
+// followed by the date as a 64-bit little-endian integer.
+const binaryVersion1 byte = 1
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
-func (d Date) MarshalBinary() (b []byte, err error) {
-	if math.MaxInt == math.MaxInt32 {
-		b = make([]byte, 4)
-		binary.LittleEndian.PutUint32(b, uint32(d))
-	} else {
-		b = make([]byte, 8)
-		binary.LittleEndian.PutUint64(b, uint64(d))
-	}
+// The result is nine bytes: a version byte followed by the date as a 64-bit integer.
+func (d Date) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 9)
+	b[0] = binaryVersion1
+	binary.LittleEndian.PutUint64(b[1:], uint64(d))
 	return b, nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It accepts the current versioned format and also the earlier unversioned formats,
+// which were four or eight bytes (depending on the platform on which they were written).
 func (d *Date) UnmarshalBinary(data []byte) error {
 	switch len(data) {
 	case 0:
 		return errors.New("Date.UnmarshalBinary: no data")
 	case 4:
-		*d = Date(binary.LittleEndian.Uint32(data))
+		// version 0 (32-bit platforms)
+		*d = Date(int32(binary.LittleEndian.Uint32(data)))
+		return nil
 	case 8:
+		// version 0 (64-bit platforms)
 		*d = Date(binary.LittleEndian.Uint64(data))
+		return nil
+	}
+
+	switch data[0] {
+	case binaryVersion1:
+		if len(data) != 9 {
+			return fmt.Errorf("Date.UnmarshalBinary: invalid length %d bytes", len(data))
+		}
+		*d = Date(binary.LittleEndian.Uint64(data[1:]))
 	default:
-		return fmt.Errorf("Date.UnmarshalBinary: invalid length %d bytes", len(data))
+		return fmt.Errorf("Date.UnmarshalBinary: unsupported version %d", data[0])
 	}
 	return nil
 }
 
+// GobEncode implements the gob.GobEncoder interface. It uses the same versioned format as
+// MarshalBinary, so the encoding stays stable even if the underlying type of Date changes.
+// Because it has a value receiver, it applies equally to Date values and pointers, whether
+// they are encoded directly, as struct fields or as registered interface values.
+func (d Date) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface. It accepts the same formats as
+// UnmarshalBinary.
+func (d *Date) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 // The date is given in ISO 8601 extended format (e.g. "2006-01-02").
 // If the year of the date falls outside the [0,9999] range, this format
@@ -48,13 +79,31 @@ func (d Date) MarshalText() ([]byte, error) {
 	return []byte(d.String()), nil
 }
 
+// MarshalTextCompact is as per MarshalText except that a '+' sign is never used. So years
+// in the range [0,9999] are given exactly as per MarshalText (e.g. "2006-01-02"), negative
+// years have a '-' sign prefix (e.g. "-0987-06-05"), and positive years with more than four
+// digits have no sign (e.g. "12345-06-07"). This suits consumers that reject a leading '+'.
+// The result can be unmarshalled using UnmarshalText.
+func (d Date) MarshalTextCompact() ([]byte, error) {
+	year, month, day := d.Date()
+	if year >= 0 {
+		return []byte(fmt.Sprintf("%04d-%02d-%02d", year, month, day)), nil
+	}
+	return []byte(fmt.Sprintf("%05d-%02d-%02d", year, month, day)), nil
+}
+
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // The date is expected to be in ISO 8601 extended format
 // (e.g. "2006-01-02", "+12345-06-07", "-0987-06-05");
 // the year must use at least 4 digits and if outside the [0,9999] range
 // must be prefixed with a + or - sign.
 // Note that the a blank string is unmarshalled as the zero value.
+//
+// Any trailing carriage return or line feed characters are ignored, so lines read from
+// text files such as "2006-01-02\r\n" can be unmarshalled directly; this is more lenient
+// than ParseISO.
 func (d *Date) UnmarshalText(data []byte) (err error) {
+	data = bytes.TrimRight(data, "\r\n")
 	if len(data) == 0 {
 		return nil
 	}
@@ -64,3 +113,78 @@ func (d *Date) UnmarshalText(data []byte) (err error) {
 	}
 	return err
 }
+
+// jsonLayout holds the layout string set by SetJSONLayout; it is empty by default.
+var jsonLayout atomic.Value
+
+// SetJSONLayout sets the layout used by MarshalJSON and UnmarshalJSON, as per Format and
+// Parse. This suits services whose JSON contract mandates a non-ISO format, such as
+// "01/02/2006". A blank layout restores the default, i.e. the ISO 8601 format of
+// MarshalText and UnmarshalText. It is safe to call concurrently with marshalling.
+//
+// The layout is global, so it affects every Date in the program, including those inside
+// NullDate and OmitZeroDate and those marshalled by libraries; it should normally be set
+// once during startup. Where different fields need different formats, use
+// MarshalJSONWithLayout and UnmarshalJSONWithLayout instead.
+func SetJSONLayout(layout string) {
+	jsonLayout.Store(layout)
+}
+
+// MarshalJSON implements the json.Marshaler interface. The date is a JSON string using
+// the layout set by SetJSONLayout, or by default the same format as MarshalText.
+func (d Date) MarshalJSON() ([]byte, error) {
+	layout, _ := jsonLayout.Load().(string)
+	return MarshalJSONWithLayout(d, layout)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The date must be a JSON string
+// using the layout set by SetJSONLayout, or by default the format accepted by
+// UnmarshalText. As with UnmarshalText, a blank string leaves the date unchanged; so
+// does JSON null, as usual for encoding/json.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Date.UnmarshalJSON: %w", err)
+	}
+	layout, _ := jsonLayout.Load().(string)
+	if layout == "" || s == "" {
+		return d.UnmarshalText([]byte(s))
+	}
+	u, err := Parse(layout, s)
+	if err == nil {
+		*d = u
+	}
+	return err
+}
+
+// MarshalJSONWithLayout marshals a date as a JSON string formatted with the given layout,
+// as per Format. If the layout is blank, the result is in the MarshalText format,
+// regardless of SetJSONLayout. This allows generated marshalers to choose the format of
+// each field, for example according to a struct tag.
+func MarshalJSONWithLayout(d Date, layout string) ([]byte, error) {
+	if layout == "" {
+		return json.Marshal(d.String())
+	}
+	return json.Marshal(d.Format(layout))
+}
+
+// UnmarshalJSONWithLayout unmarshals a JSON string containing a date formatted with the
+// given layout, as per Parse. If the layout is blank, the string is parsed using ParseISO,
+// regardless of SetJSONLayout. As with UnmarshalText, a blank string
+// gives the zero value; so does JSON null.
+func UnmarshalJSONWithLayout(data []byte, layout string) (Date, error) {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, fmt.Errorf("date.UnmarshalJSONWithLayout: %w", err)
+	}
+	if s == nil || *s == "" {
+		return 0, nil
+	}
+	if layout == "" {
+		return ParseISO(*s)
+	}
+	return Parse(layout, *s)
+}
@@ -42,6 +42,49 @@ func TestDate_gob_Encode_round_tripe(t *testing.T) {
 	}
 }
 
+func TestDate_gob_struct_round_trip(t *testing.T) {
+	type Event struct {
+		Name  string
+		On    Date
+		Until *Date
+		Any   interface{}
+	}
+	gob.Register(Date(0))
+
+	until := New(2012, time.June, 30)
+	in := Event{Name: "x", On: New(-1, time.December, 31), Until: &until, Any: New(12345, time.June, 7)}
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(in); err != nil {
+		t.Fatalf("encode error %v", err)
+	}
+
+	var out Event
+	if err := gob.NewDecoder(&b).Decode(&out); err != nil {
+		t.Fatalf("decode error %v", err)
+	}
+	if out.Name != in.Name || out.On != in.On || *out.Until != *in.Until || out.Any != in.Any {
+		t.Errorf("got %+v, want %+v", out, in)
+	}
+}
+
+func TestDate_GobEncode_matches_MarshalBinary(t *testing.T) {
+	d := New(2012, time.June, 25)
+	g, err := d.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := d.MarshalBinary()
+	if !bytes.Equal(g, b) {
+		t.Errorf("got %v, want %v", g, b)
+	}
+
+	var r Date
+	if err = r.GobDecode(g); err != nil || r != d {
+		t.Errorf("got %v, %v", r, err)
+	}
+}
+
 func TestDate_MarshalJSON_round_trip(t *testing.T) {
 	cases := []struct {
 		value Date
@@ -73,6 +116,102 @@ func TestDate_MarshalJSON_round_trip(t *testing.T) {
 	}
 }
 
+func TestSetJSONLayout(t *testing.T) {
+	defer SetJSONLayout("")
+
+	d := New(2012, time.June, 25)
+	cases := []struct {
+		layout, want string
+	}{
+		{layout: "01/02/2006", want: `"06/25/2012"`},
+		{layout: RFC1123W, want: `"Mon, 25 Jun 2012"`},
+		{layout: "", want: `"2012-06-25"`},
+	}
+	for i, c := range cases {
+		SetJSONLayout(c.layout)
+		bb, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("%d: JSON(%v) marshal error %v", i, d, err)
+		} else if string(bb) != c.want {
+			t.Errorf("%d: JSON(%v) == %s, want %s", i, d, bb, c.want)
+		}
+
+		var u Date
+		if err = json.Unmarshal([]byte(c.want), &u); err != nil {
+			t.Errorf("%d: JSON(%s) unmarshal error %v", i, c.want, err)
+		} else if u != d {
+			t.Errorf("%d: JSON(%s) unmarshal got %v", i, c.want, u)
+		}
+	}
+
+	SetJSONLayout("01/02/2006")
+	var u Date
+	if err := json.Unmarshal([]byte(`"2012-06-25"`), &u); err == nil {
+		t.Errorf("unmarshal of ISO date with layout 01/02/2006 got %v, want error", u)
+	}
+	if err := json.Unmarshal([]byte(`null`), &u); err != nil || u != 0 {
+		t.Errorf("unmarshal of null got %v, %v", u, err)
+	}
+
+	// the wrapper types use the same layout
+	if bb, err := json.Marshal(NullDate{Date: d, Valid: true}); err != nil || string(bb) != `"06/25/2012"` {
+		t.Errorf("NullDate JSON == %s, %v", bb, err)
+	}
+	var n NullDate
+	if err := json.Unmarshal([]byte(`"06/25/2012"`), &n); err != nil || !n.Valid || n.Date != d {
+		t.Errorf("NullDate unmarshal got %v, %v", n, err)
+	}
+	if bb, err := json.Marshal(OmitZeroDate(d)); err != nil || string(bb) != `"06/25/2012"` {
+		t.Errorf("OmitZeroDate JSON == %s, %v", bb, err)
+	}
+}
+
+func TestMarshalJSONWithLayout(t *testing.T) {
+	cases := []struct {
+		d      Date
+		layout string
+		want   string
+	}{
+		{d: New(2006, time.January, 2), layout: "", want: `"2006-01-02"`},
+		{d: New(2006, time.January, 2), layout: RFC1123W, want: `"Mon, 02 Jan 2006"`},
+		{d: New(2006, time.January, 2), layout: "02/01/2006", want: `"02/01/2006"`},
+		{d: New(12345, time.June, 7), layout: "", want: `"+12345-06-07"`},
+	}
+	for i, c := range cases {
+		bb, err := MarshalJSONWithLayout(c.d, c.layout)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if string(bb) != c.want {
+			t.Errorf("%d: got %s, want %s", i, bb, c.want)
+		}
+
+		d, err := UnmarshalJSONWithLayout(bb, c.layout)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if d != c.d {
+			t.Errorf("%d: got %v, want %v", i, d, c.d)
+		}
+	}
+}
+
+func TestUnmarshalJSONWithLayout_special_cases(t *testing.T) {
+	for i, data := range []string{`null`, `""`} {
+		d, err := UnmarshalJSONWithLayout([]byte(data), RFC1123)
+		if err != nil || d != Zero {
+			t.Errorf("%d: got %v, %v", i, d, err)
+		}
+	}
+
+	for i, data := range []string{`123`, `"2006-01-02"`, `"bad`} {
+		_, err := UnmarshalJSONWithLayout([]byte(data), RFC1123)
+		if err == nil {
+			t.Errorf("%d: expected an error for %s", i, data)
+		}
+	}
+}
+
 func TestDate_MarshalText_round_trip(t *testing.T) {
 	cases := []struct {
 		value Date
@@ -104,6 +243,66 @@ func TestDate_MarshalText_round_trip(t *testing.T) {
 	}
 }
 
+func TestDate_UnmarshalText_line_endings(t *testing.T) {
+	want := New(2020, time.January, 2)
+	for i, c := range []string{"2020-01-02\n", "2020-01-02\r\n", "2020-01-02\r", "2020-01-02\n\n"} {
+		var d Date
+		if err := d.UnmarshalText([]byte(c)); err != nil || d != want {
+			t.Errorf("%d: UnmarshalText(%q) == %v, %v", i, c, d, err)
+		}
+	}
+
+	for i, c := range []string{"\n", "\r\n"} {
+		d := want
+		if err := d.UnmarshalText([]byte(c)); err != nil || d != want {
+			t.Errorf("%d: UnmarshalText(%q) == %v, %v", i, c, d, err)
+		}
+	}
+
+	for i, c := range []string{"\n2020-01-02", "2020-01-02 \n", "2020-01-02\nx"} {
+		var d Date
+		if err := d.UnmarshalText([]byte(c)); err == nil {
+			t.Errorf("%d: UnmarshalText(%q) == %v, want an error", i, c, d)
+		}
+	}
+
+	if _, err := ParseISO("2020-01-02\n"); err == nil {
+		t.Errorf("ParseISO should reject a trailing newline")
+	}
+}
+
+func TestDate_MarshalTextCompact_round_trip(t *testing.T) {
+	cases := []struct {
+		value Date
+		want  string
+	}{
+		{New(-11111, time.February, 3), "-11111-02-03"},
+		{New(-1, time.December, 31), "-0001-12-31"},
+		{New(0, time.January, 1), "0000-01-01"},
+		{New(1, time.January, 1), "0001-01-01"},
+		{New(2012, time.June, 25), "2012-06-25"},
+		{New(9999, time.December, 31), "9999-12-31"},
+		{New(10000, time.January, 1), "10000-01-01"},
+		{New(12345, time.June, 7), "12345-06-07"},
+	}
+	for _, c := range cases {
+		var d Date
+		bb1, err := c.value.MarshalTextCompact()
+		if err != nil {
+			t.Errorf("Text(%v) marshal error %v", c, err)
+		} else if string(bb1) != c.want {
+			t.Errorf("Text(%v) == %q, want %q", c.value, string(bb1), c.want)
+		} else {
+			err = d.UnmarshalText(bb1)
+			if err != nil {
+				t.Errorf("Text(%v) unmarshal error %v", c.value, err)
+			} else if d != c.value {
+				t.Errorf("Text(%v) unmarshal got %v", c.value, d)
+			}
+		}
+	}
+}
+
 func TestDate_MarshalBinary_round_trip(t *testing.T) {
 	cases := []struct {
 		value Date
@@ -143,6 +342,47 @@ func TestDate_UnmarshalBinary_errors(t *testing.T) {
 	if err2 == nil {
 		t.Errorf("unmarshal no wrong length error")
 	}
+
+	err3 := d.UnmarshalBinary([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	if err3 == nil {
+		t.Errorf("unmarshal no wrong length error")
+	}
+
+	err4 := d.UnmarshalBinary([]byte{2, 0, 0, 0, 0, 0, 0, 0, 0})
+	if err4 == nil {
+		t.Errorf("unmarshal no unsupported version error")
+	}
+}
+
+func TestDate_UnmarshalBinary_versions(t *testing.T) {
+	cases := []struct {
+		data []byte
+		want Date
+	}{
+		// version 0, 32-bit
+		{[]byte{0x3a, 0xf9, 0x0a, 0x00}, New(1970, time.January, 1)},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, New(0, time.December, 31)},
+		// version 0, 64-bit
+		{[]byte{0x3a, 0xf9, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00}, New(1970, time.January, 1)},
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, New(0, time.December, 31)},
+		// version 1
+		{[]byte{0x01, 0x3a, 0xf9, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00}, New(1970, time.January, 1)},
+		{[]byte{0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, New(0, time.December, 31)},
+	}
+	for i, c := range cases {
+		var d Date
+		err := d.UnmarshalBinary(c.data)
+		if err != nil {
+			t.Errorf("%d: Binary(%v) unmarshal error %v", i, c.data, err)
+		} else if d != c.want {
+			t.Errorf("%d: Binary(%v) unmarshal got %v, want %v", i, c.data, d, c.want)
+		}
+	}
+
+	b, _ := New(1970, time.January, 1).MarshalBinary()
+	if !bytes.Equal(b, cases[4].data) {
+		t.Errorf("Binary marshal got %v, want %v", b, cases[4].data)
+	}
 }
 
 func TestDate_UnmarshalText_invalid_date_text(t *testing.T) {
@@ -0,0 +1,38 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// MonthGrid returns the dates needed to display a month as a calendar, with one row per
+// week and each week starting on firstDay. Every row has seven dates. The first row is
+// padded with days from the end of the previous month and the last row is padded with
+// days from the start of the next month, as needed.
+//
+// There are four, five or six rows, depending on the length of the month and the weekday
+// on which it starts. (Four is only possible for a non-leap February that starts on firstDay.)
+func MonthGrid(year int, month time.Month, firstDay time.Weekday) [][]Date {
+	start := New(year, month, 1).WeekdayOnOrBefore(firstDay)
+	rows := WeeksInMonth(year, month, firstDay)
+
+	grid := make([][]Date, rows)
+	for r := range grid {
+		week := make([]Date, 7)
+		for i := range week {
+			week[i] = start + Date(r*7+i)
+		}
+		grid[r] = week
+	}
+	return grid
+}
+
+// WeeksInMonth returns the number of weeks that the month spans, with each week starting on
+// firstDay, counting partial weeks at the start and end. This is the number of rows in the
+// MonthGrid of the month, so it is four, five or six.
+func WeeksInMonth(year int, month time.Month, firstDay time.Weekday) int {
+	start := New(year, month, 1).WeekdayOnOrBefore(firstDay)
+	last := New(year, month+1, 1) - 1
+	return int(last-start)/7 + 1
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonthGrid(t *testing.T) {
+	cases := []struct {
+		year        int
+		month       time.Month
+		firstDay    time.Weekday
+		rows        int
+		first, last Date
+	}{
+		// March 2024 starts on a Friday and has 31 days
+		{year: 2024, month: time.March, firstDay: time.Sunday, rows: 6, first: New(2024, time.February, 25), last: New(2024, time.April, 6)},
+		{year: 2024, month: time.March, firstDay: time.Monday, rows: 5, first: New(2024, time.February, 26), last: New(2024, time.March, 31)},
+		// September 2024 starts on a Sunday and has 30 days
+		{year: 2024, month: time.September, firstDay: time.Sunday, rows: 5, first: New(2024, time.September, 1), last: New(2024, time.October, 5)},
+		{year: 2024, month: time.September, firstDay: time.Monday, rows: 6, first: New(2024, time.August, 26), last: New(2024, time.October, 6)},
+		// February 2015 starts on a Sunday and has 28 days
+		{year: 2015, month: time.February, firstDay: time.Sunday, rows: 4, first: New(2015, time.February, 1), last: New(2015, time.February, 28)},
+		{year: -1, month: time.December, firstDay: time.Monday, rows: 5, first: New(-1, time.November, 29), last: New(0, time.January, 2)},
+	}
+	for i, c := range cases {
+		grid := MonthGrid(c.year, c.month, c.firstDay)
+		if len(grid) != c.rows {
+			t.Errorf("%d: %d %v has %d rows, want %d", i, c.year, c.month, len(grid), c.rows)
+			continue
+		}
+
+		expected := c.first
+		for r, week := range grid {
+			if len(week) != 7 {
+				t.Errorf("%d: row %d has %d dates", i, r, len(week))
+			}
+			if week[0].Weekday() != c.firstDay {
+				t.Errorf("%d: row %d starts on %v", i, r, week[0].Weekday())
+			}
+			for _, d := range week {
+				if d != expected {
+					t.Errorf("%d: row %d has %v, want %v", i, r, d, expected)
+				}
+				expected++
+			}
+		}
+
+		if grid[len(grid)-1][6] != c.last {
+			t.Errorf("%d: grid ends on %v, want %v", i, grid[len(grid)-1][6], c.last)
+		}
+	}
+}
+
+func TestWeeksInMonth(t *testing.T) {
+	cases := []struct {
+		year     int
+		month    time.Month
+		firstDay time.Weekday
+		weeks    int
+	}{
+		// February 2015 starts on a Sunday and has 28 days
+		{year: 2015, month: time.February, firstDay: time.Sunday, weeks: 4},
+		{year: 2015, month: time.February, firstDay: time.Monday, weeks: 5},
+		// February 2021 starts on a Monday and has 28 days
+		{year: 2021, month: time.February, firstDay: time.Monday, weeks: 4},
+		// February 2032 starts on a Sunday but has 29 days
+		{year: 2032, month: time.February, firstDay: time.Sunday, weeks: 5},
+		// March 2024 starts on a Friday and has 31 days
+		{year: 2024, month: time.March, firstDay: time.Sunday, weeks: 6},
+		{year: 2024, month: time.March, firstDay: time.Monday, weeks: 5},
+		// June 2024 starts on a Saturday and has 30 days
+		{year: 2024, month: time.June, firstDay: time.Sunday, weeks: 6},
+		{year: 2024, month: time.June, firstDay: time.Saturday, weeks: 5},
+	}
+	for i, c := range cases {
+		if n := WeeksInMonth(c.year, c.month, c.firstDay); n != c.weeks {
+			t.Errorf("%d: WeeksInMonth(%d, %v, %v) == %d, want %d", i, c.year, c.month, c.firstDay, n, c.weeks)
+		}
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		for firstDay := time.Sunday; firstDay <= time.Saturday; firstDay++ {
+			if n := WeeksInMonth(2023, month, firstDay); n != len(MonthGrid(2023, month, firstDay)) {
+				t.Errorf("WeeksInMonth(2023, %v, %v) == %d", month, firstDay, n)
+			}
+		}
+	}
+}
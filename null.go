@@ -0,0 +1,99 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullDate represents a Date that may be null, for example in a nullable SQL column or an
+// optional JSON field. It is similar to sql.NullTime.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// Scan implements sql.Scanner. A NULL value sets Valid to false; any other value is
+// scanned as per Date.Scan and sets Valid to true.
+func (n *NullDate) Scan(value interface{}) error {
+	if value == nil {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	n.Valid = true
+	return n.Date.Scan(value)
+}
+
+// Value implements driver.Valuer. If Valid is false, the result is nil (i.e. NULL);
+// otherwise it is as per Date.Value.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// MarshalJSON implements json.Marshaler. If Valid is false, the result is null;
+// otherwise the date is given as a string, as per Date.MarshalJSON, i.e. in the layout
+// set by SetJSONLayout or by default in the MarshalText format.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Date)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null sets Valid to false; a string
+// is parsed as per Date.UnmarshalJSON, i.e. in the layout set by SetJSONLayout or by
+// default as per UnmarshalText, and sets Valid to true.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	var d Date
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	n.Date, n.Valid = d, true
+	return nil
+}
+
+// OmitZeroDate is a Date that is marshalled to JSON as null when it equals Zero, for optional
+// fields where a nil pointer or NullDate would be awkward. Unlike NullDate, it cannot
+// distinguish an explicit Zero from a missing value. Convert to and from Date as needed,
+// e.g. Date(od).
+type OmitZeroDate Date
+
+// MarshalJSON implements json.Marshaler. Zero gives null; any other date is given as a
+// string, as per Date.MarshalJSON, so the layout set by SetJSONLayout applies.
+func (od OmitZeroDate) MarshalJSON() ([]byte, error) {
+	if Date(od).IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(Date(od))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null gives Zero; a string is parsed as
+// per Date.UnmarshalJSON, so the layout set by SetJSONLayout applies.
+func (od *OmitZeroDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*od = OmitZeroDate(Zero)
+		return nil
+	}
+	var d Date
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	*od = OmitZeroDate(d)
+	return nil
+}
+
+// String returns the date in ISO 8601 extended format, as per Date.String.
+func (od OmitZeroDate) String() string {
+	return Date(od).String()
+}
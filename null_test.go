@@ -0,0 +1,130 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullDate_Scan_and_Value(t *testing.T) {
+	cases := []struct {
+		v        interface{}
+		expected NullDate
+	}{
+		{v: nil, expected: NullDate{}},
+		{v: int64(ZeroOffset), expected: NullDate{Date: New(1970, time.January, 1), Valid: true}},
+		{v: "2020-02-29", expected: NullDate{Date: New(2020, time.February, 29), Valid: true}},
+		{v: []byte("31/12/1999"), expected: NullDate{Date: New(1999, time.December, 31), Valid: true}},
+		{v: time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), expected: NullDate{Date: New(2021, time.March, 4), Valid: true}},
+	}
+
+	for i, c := range cases {
+		n := NullDate{Date: 123, Valid: true}
+		if err := n.Scan(c.v); err != nil {
+			t.Errorf("%d: Scan(%v) gave %v", i, c.v, err)
+		}
+		if n != c.expected {
+			t.Errorf("%d: Scan(%v) gave %+v, want %+v", i, c.v, n, c.expected)
+		}
+
+		v, err := n.Value()
+		if err != nil {
+			t.Errorf("%d: Value() gave %v", i, err)
+		}
+		if c.expected.Valid {
+			if v != c.expected.Date.String() {
+				t.Errorf("%d: Value() gave %v", i, v)
+			}
+		} else if v != nil {
+			t.Errorf("%d: Value() gave %v, want nil", i, v)
+		}
+	}
+
+	var n NullDate
+	if err := n.Scan(true); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestNullDate_JSON_round_trip(t *testing.T) {
+	type record struct {
+		On NullDate `json:"on"`
+	}
+	cases := []struct {
+		n    NullDate
+		json string
+	}{
+		{n: NullDate{}, json: `{"on":null}`},
+		{n: NullDate{Date: New(2020, time.February, 29), Valid: true}, json: `{"on":"2020-02-29"}`},
+		{n: NullDate{Date: New(-12345, time.June, 7), Valid: true}, json: `{"on":"-12345-06-07"}`},
+		{n: NullDate{Date: Zero, Valid: true}, json: `{"on":"0001-01-01"}`},
+	}
+
+	for i, c := range cases {
+		bb, err := json.Marshal(record{On: c.n})
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if string(bb) != c.json {
+			t.Errorf("%d: got %s, want %s", i, bb, c.json)
+		}
+
+		r := record{On: NullDate{Date: 123, Valid: true}}
+		if err = json.Unmarshal(bb, &r); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if r.On != c.n {
+			t.Errorf("%d: got %+v, want %+v", i, r.On, c.n)
+		}
+	}
+
+	var n NullDate
+	if err := json.Unmarshal([]byte(`"2020-13-45x"`), &n); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestOmitZeroDate_JSON_round_trip(t *testing.T) {
+	type record struct {
+		On OmitZeroDate `json:"on"`
+	}
+	cases := []struct {
+		d    Date
+		json string
+	}{
+		{d: Zero, json: `{"on":null}`},
+		{d: New(2020, time.February, 29), json: `{"on":"2020-02-29"}`},
+		{d: New(-12345, time.June, 7), json: `{"on":"-12345-06-07"}`},
+		{d: Zero + 1, json: `{"on":"0001-01-02"}`},
+	}
+
+	for i, c := range cases {
+		bb, err := json.Marshal(record{On: OmitZeroDate(c.d)})
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if string(bb) != c.json {
+			t.Errorf("%d: got %s, want %s", i, bb, c.json)
+		}
+
+		r := record{On: 123}
+		if err = json.Unmarshal(bb, &r); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if Date(r.On) != c.d {
+			t.Errorf("%d: got %v, want %v", i, r.On, c.d)
+		}
+	}
+
+	var od OmitZeroDate
+	if err := json.Unmarshal([]byte(`"0001-01-01"`), &od); err != nil || Date(od) != Zero {
+		t.Errorf("got %v, %v", od, err)
+	}
+	if err := json.Unmarshal([]byte(`"2020-13-45x"`), &od); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NumericKind specifies how ParseNumeric interprets a number.
+type NumericKind int
+
+const (
+	// EpochDays is the number of days since 0001-01-01, as per DaysSinceEpoch.
+	EpochDays NumericKind = iota
+	// UnixSeconds is the number of seconds since 1970-01-01T00:00:00Z.
+	UnixSeconds
+	// UnixMillis is the number of milliseconds since 1970-01-01T00:00:00Z.
+	UnixMillis
+)
+
+var numericKindNames = []string{"EpochDays", "UnixSeconds", "UnixMillis"}
+
+// String returns the name of the kind, e.g. "UnixSeconds".
+func (k NumericKind) String() string {
+	if EpochDays <= k && k <= UnixMillis {
+		return numericKindNames[k]
+	}
+	return "unknown"
+}
+
+// ParseNumeric parses a date given as an integer, such as "719162" or "1577923200".
+// There is no way to tell from the number itself what it counts, so the kind must be
+// stated explicitly. For Unix times, the result is the UTC date on which that instant
+// falls. Surrounding whitespace is ignored; anything other than an optionally-signed
+// integer is an error.
+func ParseNumeric(s string, kind NumericKind) (Date, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("date.ParseNumeric: cannot parse %q: not an integer", s)
+	}
+
+	switch kind {
+	case EpochDays:
+		return Date(n), nil
+	case UnixSeconds:
+		return Date(floorDiv64(n, secondsPerDay) + ZeroOffset), nil
+	case UnixMillis:
+		return Date(floorDiv64(n, 1000*secondsPerDay) + ZeroOffset), nil
+	}
+	return 0, fmt.Errorf("date.ParseNumeric: unknown numeric kind %d", kind)
+}
@@ -0,0 +1,60 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseNumeric(t *testing.T) {
+	cases := []struct {
+		s    string
+		kind NumericKind
+		want Date
+	}{
+		{s: "719162", kind: EpochDays, want: New(1970, time.January, 1)},
+		{s: "737425", kind: EpochDays, want: New(2020, time.January, 2)},
+		{s: "1577923200", kind: UnixSeconds, want: New(2020, time.January, 2)},
+		{s: "1578009599", kind: UnixSeconds, want: New(2020, time.January, 2)},
+		{s: "1577923200000", kind: UnixMillis, want: New(2020, time.January, 2)},
+		{s: " 1577923200123 ", kind: UnixMillis, want: New(2020, time.January, 2)},
+		{s: "0", kind: UnixSeconds, want: New(1970, time.January, 1)},
+		{s: "-1", kind: UnixSeconds, want: New(1969, time.December, 31)},
+		{s: "-1", kind: UnixMillis, want: New(1969, time.December, 31)},
+		{s: "-86400", kind: UnixSeconds, want: New(1969, time.December, 31)},
+		{s: "-86401", kind: UnixSeconds, want: New(1969, time.December, 30)},
+		{s: "-1", kind: EpochDays, want: New(0, time.December, 31)},
+	}
+	for i, c := range cases {
+		d, err := ParseNumeric(c.s, c.kind)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+		if d != c.want {
+			t.Errorf("%d: ParseNumeric(%q, %v) == %v, want %v", i, c.s, c.kind, d, c.want)
+		}
+	}
+}
+
+func TestParseNumeric_errors(t *testing.T) {
+	cases := []struct {
+		s    string
+		kind NumericKind
+		want string
+	}{
+		{s: "", kind: EpochDays, want: `date.ParseNumeric: cannot parse "": not an integer`},
+		{s: "1.5", kind: UnixSeconds, want: `date.ParseNumeric: cannot parse "1.5": not an integer`},
+		{s: "2020-01-02", kind: UnixMillis, want: `date.ParseNumeric: cannot parse "2020-01-02": not an integer`},
+		{s: "1e9", kind: UnixSeconds, want: `date.ParseNumeric: cannot parse "1e9": not an integer`},
+		{s: "123", kind: 99, want: `date.ParseNumeric: unknown numeric kind 99`},
+	}
+	for i, c := range cases {
+		_, err := ParseNumeric(c.s, c.kind)
+		if err == nil || err.Error() != c.want {
+			t.Errorf("%d: got %v, want %s", i, err, c.want)
+		}
+	}
+}
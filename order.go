@@ -0,0 +1,178 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rickb777/date/v2/gregorian"
+)
+
+// FieldOrder specifies the order of the year, month and day fields in a numeric date string.
+type FieldOrder int
+
+const (
+	// DMY is day/month/year order, typical in Britain and much of Europe.
+	DMY FieldOrder = iota
+	// MDY is month/day/year order, typical in the USA.
+	MDY
+	// YMD is year/month/day order, as used by ISO 8601.
+	YMD
+)
+
+var fieldOrderNames = []string{"DMY", "MDY", "YMD"}
+
+// String returns the name of the field order, e.g. "DMY".
+func (o FieldOrder) String() string {
+	if DMY <= o && o <= YMD {
+		return fieldOrderNames[o]
+	}
+	return "unknown"
+}
+
+// MustParseOrder is as per ParseOrder except that it panics if the string cannot be parsed.
+// This is intended for setup code; don't use it for user inputs.
+func MustParseOrder(value string, order FieldOrder) Date {
+	d, err := ParseOrder(value, order)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseOrder parses a numeric date string in which the order of the fields is known in
+// advance, such as "01/02/2003". Unlike AutoParse, no attempt is made to guess the order,
+// so the result is deterministic regardless of the values; for example, "01/02/2003" is
+// 2nd January under MDY but 1st February under DMY.
+//
+// The three fields must be separated by the same punctuation or space character, e.g.
+// "01/02/2003", "1.2.2003" or "2003-02-01". The year must have at least four digits; the
+// month and day have one or two digits and must be valid for that year.
+// Surrounding whitespace is ignored.
+func ParseOrder(value string, order FieldOrder) (Date, error) {
+	d, err := parseOrder(strings.TrimSpace(value), order)
+	if err != nil {
+		return 0, fmt.Errorf("date.ParseOrder: cannot parse %q as %s: %w", value, order, err)
+	}
+	return d, nil
+}
+
+// DetectOrder inspects a batch of numeric date strings, such as a column of a data feed,
+// and infers the field order that they use. It does this by finding which of DMY, MDY and
+// YMD allows every sample to be parsed by ParseOrder. For example, a sample such as
+// "25/12/2003" rules out MDY because 25 is not a valid month.
+//
+// An error is returned if there are no samples, if no single order fits all the samples,
+// or if more than one order fits them all (i.e. the samples are ambiguous).
+func DetectOrder(samples []string) (FieldOrder, error) {
+	if len(samples) == 0 {
+		return 0, errors.New("date.DetectOrder: no samples")
+	}
+
+	var candidates []FieldOrder
+	for _, order := range []FieldOrder{DMY, MDY, YMD} {
+		if fitsAll(samples, order) {
+			candidates = append(candidates, order)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return 0, errors.New("date.DetectOrder: no field order fits all the samples")
+	case 1:
+		return candidates[0], nil
+	}
+	return 0, fmt.Errorf("date.DetectOrder: the samples are ambiguous; they fit %v", candidates)
+}
+
+func fitsAll(samples []string, order FieldOrder) bool {
+	for _, s := range samples {
+		if _, err := parseOrder(strings.TrimSpace(s), order); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func parseOrder(value string, order FieldOrder) (Date, error) {
+	f1, f2, f3, err := splitFields(value)
+	if err != nil {
+		return 0, err
+	}
+
+	var yyyy, mm, dd string
+	switch order {
+	case DMY:
+		dd, mm, yyyy = f1, f2, f3
+	case MDY:
+		mm, dd, yyyy = f1, f2, f3
+	case YMD:
+		yyyy, mm, dd = f1, f2, f3
+	default:
+		return 0, fmt.Errorf("unknown field order %d", order)
+	}
+
+	year, e1 := parseField(yyyy, "year", 4, -1)
+	month, e2 := parseShortField(mm, "month", 12)
+	day, e3 := parseShortField(dd, "day", 31)
+	if err = errors.Join(e1, e2, e3); err != nil {
+		return 0, err
+	}
+
+	if day > gregorian.DaysIn(year, time.Month(month)) {
+		return 0, &ParseError{Field: "day", Value: dd, Err: errOutOfRange}
+	}
+
+	return New(year, time.Month(month), day), nil
+}
+
+// splitFields splits a string into three fields of digits, separated by two identical
+// punctuation or space characters.
+func splitFields(value string) (f1, f2, f3 string, err error) {
+	i1 := strings.IndexFunc(value, isNotDigit)
+	if i1 <= 0 {
+		return "", "", "", errors.New("incorrect syntax")
+	}
+
+	sep := rune(value[i1])
+	if !unicode.IsPunct(sep) && !unicode.IsSpace(sep) {
+		return "", "", "", errors.New("incorrect syntax")
+	}
+
+	parts := strings.Split(value, string(sep))
+	if len(parts) != 3 {
+		return "", "", "", errors.New("incorrect syntax")
+	}
+
+	for _, p := range parts {
+		if p == "" || strings.IndexFunc(p, isNotDigit) >= 0 {
+			return "", "", "", errors.New("incorrect syntax")
+		}
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func parseShortField(field, name string, max int) (int, error) {
+	if len(field) < 1 || len(field) > 2 {
+		return 0, &ParseError{Field: name, Value: field, Err: errWrongLength}
+	}
+	number, err := parseField(field, name, -1, -1)
+	if err != nil {
+		return 0, err
+	}
+	if number < 1 || number > max {
+		return 0, &ParseError{Field: name, Value: field, Err: errOutOfRange}
+	}
+	return number, nil
+}
+
+func isNotDigit(r rune) bool {
+	return r < '0' || r > '9'
+}
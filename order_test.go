@@ -0,0 +1,111 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseOrder(t *testing.T) {
+	cases := []struct {
+		value string
+		order FieldOrder
+		want  Date
+	}{
+		{value: "01/02/2003", order: MDY, want: New(2003, time.January, 2)},
+		{value: "01/02/2003", order: DMY, want: New(2003, time.February, 1)},
+		{value: "2003/02/01", order: YMD, want: New(2003, time.February, 1)},
+		{value: "1.2.2003", order: DMY, want: New(2003, time.February, 1)},
+		{value: "1.2.2003", order: MDY, want: New(2003, time.January, 2)},
+		{value: " 2003-2-1 ", order: YMD, want: New(2003, time.February, 1)},
+		{value: "31 12 1999", order: DMY, want: New(1999, time.December, 31)},
+		{value: "12/31/1999", order: MDY, want: New(1999, time.December, 31)},
+		{value: "29/02/2000", order: DMY, want: New(2000, time.February, 29)},
+		{value: "01/02/12345", order: DMY, want: New(12345, time.February, 1)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s %s", i, c.value, c.order), func(t *testing.T) {
+			d := MustParseOrder(c.value, c.order)
+			if d != c.want {
+				t.Errorf("%d: ParseOrder(%q, %s) == %v, want %v", i, c.value, c.order, d, c.want)
+			}
+		})
+	}
+}
+
+func TestParseOrder_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		order FieldOrder
+		want  string
+	}{
+		{value: "", order: DMY, want: `date.ParseOrder: cannot parse "" as DMY: incorrect syntax`},
+		{value: "01022003", order: DMY, want: `date.ParseOrder: cannot parse "01022003" as DMY: incorrect syntax`},
+		{value: "01/02-2003", order: DMY, want: `date.ParseOrder: cannot parse "01/02-2003" as DMY: incorrect syntax`},
+		{value: "01/02/2003/04", order: DMY, want: `date.ParseOrder: cannot parse "01/02/2003/04" as DMY: incorrect syntax`},
+		{value: "01//2003", order: DMY, want: `date.ParseOrder: cannot parse "01//2003" as DMY: incorrect syntax`},
+		{value: "0a/02/2003", order: DMY, want: `date.ParseOrder: cannot parse "0a/02/2003" as DMY: incorrect syntax`},
+		{value: "01/02/03", order: DMY, want: `date.ParseOrder: cannot parse "01/02/03" as DMY: year has wrong length`},
+		{value: "13/01/2003", order: MDY, want: `date.ParseOrder: cannot parse "13/01/2003" as MDY: month out of range`},
+		{value: "00/01/2003", order: DMY, want: `date.ParseOrder: cannot parse "00/01/2003" as DMY: day out of range`},
+		{value: "001/01/2003", order: DMY, want: `date.ParseOrder: cannot parse "001/01/2003" as DMY: day has wrong length`},
+		{value: "30/02/2003", order: DMY, want: `date.ParseOrder: cannot parse "30/02/2003" as DMY: day out of range`},
+		{value: "2003/01/02", order: DMY, want: `date.ParseOrder: cannot parse "2003/01/02" as DMY: year has wrong length` + "\nday has wrong length"},
+		{value: "01/02/2003", order: FieldOrder(9), want: `date.ParseOrder: cannot parse "01/02/2003" as unknown: unknown field order 9`},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
+			d, err := ParseOrder(c.value, c.order)
+			if err == nil {
+				t.Fatalf("ParseOrder(%q) == %v", c.value, d)
+			}
+			if err.Error() != c.want {
+				t.Errorf("got %s\nwant %s", err.Error(), c.want)
+			}
+		})
+	}
+}
+
+func TestDetectOrder(t *testing.T) {
+	cases := []struct {
+		samples []string
+		want    FieldOrder
+	}{
+		{samples: []string{"01/02/2003", "25/12/2003", "07/07/2007"}, want: DMY},
+		{samples: []string{"01/02/2003", "12/25/2003", "07/07/2007"}, want: MDY},
+		{samples: []string{"2003-02-01", "2003-12-25"}, want: YMD},
+		{samples: []string{" 13.1.2020", "1.1.2020 "}, want: DMY},
+	}
+	for i, c := range cases {
+		order, err := DetectOrder(c.samples)
+		if err != nil {
+			t.Errorf("%d: DetectOrder(%v) unexpected error %v", i, c.samples, err)
+		} else if order != c.want {
+			t.Errorf("%d: DetectOrder(%v) == %v, want %v", i, c.samples, order, c.want)
+		}
+	}
+}
+
+func TestDetectOrder_errors(t *testing.T) {
+	cases := []struct {
+		samples []string
+		want    string
+	}{
+		{samples: nil, want: "date.DetectOrder: no samples"},
+		{samples: []string{"01/02/2003", "07/07/2007"}, want: "date.DetectOrder: the samples are ambiguous; they fit [DMY MDY]"},
+		{samples: []string{"25/12/2003", "12/25/2003"}, want: "date.DetectOrder: no field order fits all the samples"},
+		{samples: []string{"01/02/2003", "not a date"}, want: "date.DetectOrder: no field order fits all the samples"},
+	}
+	for i, c := range cases {
+		order, err := DetectOrder(c.samples)
+		if err == nil {
+			t.Errorf("%d: DetectOrder(%v) == %v, want error", i, c.samples, order)
+		} else if err.Error() != c.want {
+			t.Errorf("%d: got %s\nwant %s", i, err.Error(), c.want)
+		}
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
+
+	"github.com/rickb777/date/v2/gregorian"
 )
 
 // MustAutoParse is as per AutoParse except that it panics if the string cannot be parsed.
@@ -49,9 +52,45 @@ func MustAutoParseUS(value string) Date {
 //
 // * d/m/yyyy | d.m.yyyy (or any similar pattern)
 //
+// * d/m/yy | dd.mm.yy (or any similar pattern except with dashes), using TwoDigitYearPivot
+// for the century
+//
+// * yyyy/m/d | yyyy.m.d (or any similar pattern except with dashes)
+//
 // * surrounding whitespace is ignored
 func AutoParse(value string) (Date, error) {
-	return autoParse(value, func(yyyy, f1, f2 string) string { return fmt.Sprintf("%s-%s-%s", yyyy, f1, f2) })
+	return autoParse(value, unicode.IsPunct, composeDMY, TwoDigitYearPivot)
+}
+
+// AutoParseWithPivot is as per AutoParse except that the century of two-digit years is
+// determined by pivot instead of TwoDigitYearPivot. Two-digit years less than the pivot
+// are in the 2000s and the others are in the 1900s; for example, with a pivot of 30,
+// "1.2.29" is 1st February 2029 but "1.2.30" is 1st February 1930.
+func AutoParseWithPivot(value string, pivot int) (Date, error) {
+	return autoParse(value, unicode.IsPunct, composeDMY, pivot)
+}
+
+// AutoParseSep is as per AutoParse except that only the characters in seps are accepted
+// as separators between the date fields, instead of any punctuation. For example, with
+// seps "/.-", the value "02/01/2020" is accepted but "02'01'2020" is not. Any other
+// punctuation in the date is an error (although any time part after 'T' is unaffected).
+func AutoParseSep(value string, seps string) (Date, error) {
+	isSep := func(r rune) bool { return strings.ContainsRune(seps, r) }
+
+	date, _, _ := strings.Cut(strings.TrimSpace(value), "T")
+	if len(date) > 0 && (date[0] == '+' || date[0] == '-') {
+		date = date[1:]
+	}
+	if i := strings.IndexFunc(date, func(r rune) bool { return unicode.IsPunct(r) && !isSep(r) }); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(date[i:])
+		return 0, fmt.Errorf("date.AutoParseSep: cannot parse %q: separator %q is not allowed", value, r)
+	}
+
+	return autoParse(value, isSep, composeDMY, TwoDigitYearPivot)
+}
+
+func composeDMY(yyyy, f1, f2 string) string {
+	return fmt.Sprintf("%s-%s-%s", yyyy, f1, f2)
 }
 
 // AutoParseUS is like ParseISO, except that it automatically adapts to a variety of date formats
@@ -69,12 +108,17 @@ func AutoParse(value string) (Date, error) {
 //
 // * m/d/yyyy | m.d.yyyy (or any similar pattern)
 //
+// * m/d/yy | mm.dd.yy (or any similar pattern except with dashes), using TwoDigitYearPivot
+// for the century
+//
+// * yyyy/m/d | yyyy.m.d (or any similar pattern except with dashes)
+//
 // * surrounding whitespace is ignored
 func AutoParseUS(value string) (Date, error) {
-	return autoParse(value, func(yyyy, f1, f2 string) string { return fmt.Sprintf("%s-%s-%s", yyyy, f2, f1) })
+	return autoParse(value, unicode.IsPunct, func(yyyy, f1, f2 string) string { return fmt.Sprintf("%s-%s-%s", yyyy, f2, f1) }, TwoDigitYearPivot)
 }
 
-func autoParse(value string, compose func(yyyy, f1, f2 string) string) (Date, error) {
+func autoParse(value string, isSep func(rune) bool, compose func(yyyy, f1, f2 string) string, pivot int) (Date, error) {
 	abs := strings.TrimSpace(value)
 	if len(abs) == 0 {
 		return 0, errors.New("Date.AutoParse: cannot parse a blank string")
@@ -86,11 +130,11 @@ func autoParse(value string, compose func(yyyy, f1, f2 string) string) (Date, er
 		abs = abs[1:]
 	}
 
-	if len(abs) >= 8 {
+	if len(abs) >= 5 {
 		i1 := -1
 		i2 := -1
 		for i, r := range abs {
-			if unicode.IsPunct(r) {
+			if isSep(r) {
 				if i1 < 0 {
 					i1 = i
 				} else {
@@ -100,29 +144,75 @@ func autoParse(value string, compose func(yyyy, f1, f2 string) string) (Date, er
 		}
 
 		if i1 >= 4 && i2 > i1 && abs[i1] == abs[i2] {
-			// just normalise the punctuation
-			a := []byte(abs)
-			a[i1] = '-'
-			a[i2] = '-'
-			abs = string(a)
+			// year first - just normalise the punctuation (and the month & day widths,
+			// except for dashes, which must follow ISO 8601 strictly)
+			yyyy, mm, dd := abs[:i1], abs[i1+1:i2], abs[i2+1:]
+			if abs[i1] != '-' {
+				mm, dd = zeroPad(mm), zeroPad(dd)
+			}
+			abs = yyyy + "-" + mm + "-" + dd
 
 		} else if i1 >= 1 && i2 > i1 && abs[i1] == abs[i2] {
 			// harder case - need to swap the field order
-			f1 := abs[0:i1]      // day or month
-			f2 := abs[i1+1 : i2] // month or day
-			if len(f1) == 1 {
-				f1 = "0" + f1
-			}
-			if len(f2) == 1 {
-				f2 = "0" + f2
-			}
+			f1 := zeroPad(abs[0:i1])      // day or month
+			f2 := zeroPad(abs[i1+1 : i2]) // month or day
 			yyyy := abs[i2+1:]
+			if len(yyyy) == 2 && sign == "" && abs[i1] != '-' {
+				// (dashes are excluded because yy-mm-dd would be ambiguous)
+				yyyy = expandTwoDigitYear(yyyy, pivot)
+			}
 			abs = compose(yyyy, f2, f1)
 		}
 	}
 	return parseISO(value, sign+abs)
 }
 
+// TwoDigitYearPivot determines the century of two-digit years accepted by AutoParse and
+// AutoParseUS, e.g. in "2.1.20". Two-digit years less than the pivot are in the 2000s;
+// the others are in the 1900s. So "20" is 2020 and "70" is 1970. Use AutoParseWithPivot
+// for a different pivot.
+const TwoDigitYearPivot = 69
+
+func expandTwoDigitYear(yy string, pivot int) string {
+	y, err := strconv.Atoi(yy)
+	if err != nil {
+		return yy // let the parser report the error
+	}
+	if y < pivot {
+		return strconv.Itoa(2000 + y)
+	}
+	return strconv.Itoa(1900 + y)
+}
+
+func zeroPad(field string) string {
+	if len(field) == 1 {
+		return "0" + field
+	}
+	return field
+}
+
+// ParseList parses a list of dates separated by sep, such as "2020-01-01,2020-06-15".
+// Each element is trimmed of surrounding whitespace and parsed using AutoParse.
+//
+// Empty elements are skipped, so a blank string gives an empty list and a trailing
+// separator is allowed. If an element cannot be parsed, the error reports its index,
+// counting from zero and including any empty elements.
+func ParseList(s, sep string) ([]Date, error) {
+	var result []Date
+	for i, element := range strings.Split(s, sep) {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		d, err := AutoParse(element)
+		if err != nil {
+			return nil, fmt.Errorf("date.ParseList: element %d: %w", i, err)
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
 // MustParseISO is as per ParseISO except that it panics if the string cannot be parsed.
 // This is intended for setup code; don't use it for user inputs.
 func MustParseISO(value string) Date {
@@ -137,17 +227,33 @@ func MustParseISO(value string) Date {
 // It accepts the following formats:
 //
 //   - the common formats ±YYYY-MM-DD and ±YYYYMMDD (e.g. 2006-01-02 and 20060102)
-//   - the ordinal date representation ±YYYY-OOO (e.g. 2006-217)
+//   - the ordinal date representations ±YYYY-OOO and ±YYYYOOO (e.g. 2006-217 and +2006217)
+//   - the week date representations ±YYYY-Www-D and ±YYYYWwwD (e.g. 2006-W01-1 and 2006W011)
+//
+// The year must have at least four digits; any longer run of digits is accepted uniformly,
+// so leading zeros are allowed whether or not there is a sign. For example, "2015-08-15",
+// "0215-08-15" and "00215-08-15" are all accepted (the last two being in the year 215) but
+// "215-08-15" is rejected because it has too few year digits.
 //
 // For common formats, ParseISO will accept dates with more year digits than the four-digit
 // minimum. A leading plus '+' sign is allowed and ignored. Basic format (without '-'
-// separators) is allowed.
+// separators) is allowed; in basic format, years of more than four digits must have a
+// leading '+' or '-' sign (e.g. +202000102), because otherwise a string such as 202000102
+// would be ambiguous.
 //
 // If a time field is present, it is ignored. For example, "2018-02-03T00:00:00Z" is parsed as
-// 3rd February 2018.
+// 3rd February 2018 and "2018-034T00:00:00Z" is the same date as an ordinal date. Likewise,
+// a trailing 'Z' or ±HH:MM zone offset without any time is ignored, so "2018-02-03Z" and
+// "2018-02-03+05:30" are also 3rd February 2018; however, a malformed offset such as
+// "2018-02-03+5" is an error.
 //
-// For ordinal dates, the extended format (including '-') is supported, but the basic format
-// (without '-') is not supported because it could not be distinguished from the YYYYMMDD format.
+// For ordinal dates, the extended format (including '-') is supported. The basic format
+// (without '-') is only supported with a sign and a four-digit year, i.e. ±YYYYOOO (e.g.
+// +2006217), because it is then shorter than any basic calendar date; otherwise it could
+// not be distinguished from the YYYYMMDD format, so 20060217 is 17th February.
+// Week dates are recognised by the 'W' marker, so both formats are supported; the week
+// must exist in the ISO week-numbering year and the day of the week is from 1 (Monday)
+// to 7 (Sunday).
 //
 // See also date.Parse, which can be used to parse date strings in other formats; however, it
 // only accepts years represented with exactly four digits.
@@ -158,38 +264,224 @@ func ParseISO(value string) (Date, error) {
 	return parseISO(value, value)
 }
 
+// ParseISOLenient is as per ParseISO except that it first removes any surrounding whitespace
+// and then a single pair of surrounding double or single quotes, such as when dates are
+// copied from JSON. For example, ` "2020-01-02" ` is parsed as 2nd January 2020.
+func ParseISOLenient(value string) (Date, error) {
+	v := strings.TrimSpace(value)
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		v = v[1 : len(v)-1]
+	}
+	return parseISO(value, v)
+}
+
+// ParseISOLax is as per ParseISO except that, in the extended calendar date format, the
+// month and day may have one digit instead of two. For example, "2020-1-2", "2020-01-2"
+// and "2020-1-02" are all 2nd January 2020. Empty fields are still rejected, as are all
+// the other forms that ParseISO rejects.
+func ParseISOLax(value string) (Date, error) {
+	sign := ""
+	abs := value
+	if len(value) > 0 && (value[0] == '+' || value[0] == '-') {
+		sign, abs = value[:1], value[1:]
+	}
+
+	dash1 := strings.IndexByte(abs, '-')
+	if dash1 < 0 {
+		return parseISO(value, value)
+	}
+	dash2 := strings.IndexByte(abs[dash1+1:], '-')
+	if dash2 < 0 {
+		return parseISO(value, value)
+	}
+	dash2 += dash1 + 1
+
+	dayEnd := strings.IndexFunc(abs[dash2+1:], isNotDigit)
+	if dayEnd < 0 {
+		dayEnd = len(abs)
+	} else {
+		dayEnd += dash2 + 1
+	}
+
+	month := abs[dash1+1 : dash2]
+	day := abs[dash2+1 : dayEnd]
+	if len(month) == 1 {
+		month = "0" + month
+	}
+	if len(day) == 1 {
+		day = "0" + day
+	}
+	return parseISO(value, sign+abs[:dash1+1]+month+"-"+day+abs[dayEnd:])
+}
+
+// Precision is the granularity of a date parsed by ParseISOReduced.
+type Precision int
+
+// These are the precisions returned by ParseISOReduced.
+const (
+	DayPrecision Precision = iota
+	MonthPrecision
+	YearPrecision
+)
+
+var precisionNames = []string{"day", "month", "year"}
+
+// String returns the name of the precision in lowercase.
+func (p Precision) String() string {
+	if DayPrecision <= p && p <= YearPrecision {
+		return precisionNames[p]
+	}
+	return "unknown"
+}
+
+// ParseISOReduced parses an ISO 8601 date that may have reduced precision, i.e. a year
+// alone (e.g. "2020") or a year and month (e.g. "2020-06"), as well as the complete
+// representations accepted by ParseISO. It returns the first date of the period and its
+// precision, which is YearPrecision, MonthPrecision or DayPrecision respectively. For
+// example, "2020-06" gives 1st June 2020 with MonthPrecision.
+//
+// A year alone has exactly four digits or, with a leading '+' or '-' sign, four to seven
+// digits; longer runs of digits are treated as basic-format dates by ParseISO. A year and
+// month must be separated by '-' and the month must have two digits, because ISO 8601
+// does not allow the basic format YYYYMM.
+//
+// See also timespan.ParsePartial, which returns the whole period as a range instead.
+func ParseISOReduced(value string) (Date, Precision, error) {
+	abs := value
+	sign := 1
+	signed := false
+	if len(value) > 0 && (value[0] == '+' || value[0] == '-') {
+		abs = value[1:]
+		signed = true
+		if value[0] == '-' {
+			sign = -1
+		}
+	}
+
+	dash := strings.IndexByte(abs, '-')
+	switch {
+	case dash < 0 && (len(abs) == 4 || (signed && 4 <= len(abs) && len(abs) < 8)):
+		year, err := parseField(abs, "year", 4, -1)
+		if err != nil {
+			return 0, YearPrecision, fmt.Errorf("date.ParseISOReduced: cannot parse %q: %w", value, err)
+		}
+		return New(sign*year, time.January, 1), YearPrecision, nil
+
+	case dash > 0 && len(abs) == dash+3:
+		year, e1 := parseField(abs[:dash], "year", 4, -1)
+		month, e2 := parseField(abs[dash+1:], "month", -1, 2)
+		if e2 == nil && (month < 1 || month > 12) {
+			e2 = &ParseError{Field: "month", Value: abs[dash+1:], Err: errOutOfRange}
+		}
+		if err := errors.Join(e1, e2); err != nil {
+			return 0, MonthPrecision, fmt.Errorf("date.ParseISOReduced: cannot parse %q: %w", value, err)
+		}
+		return New(sign*year, time.Month(month), 1), MonthPrecision, nil
+	}
+
+	d, err := parseISODate(value, value)
+	if err != nil {
+		return 0, DayPrecision, fmt.Errorf("date.ParseISOReduced: %w", err)
+	}
+	return d, DayPrecision, nil
+}
+
+// ParseRFC3339 parses an RFC 3339 full-date string and returns the date value it represents.
+// This is stricter than ParseISO: only the "YYYY-MM-DD" form is accepted, having exactly four
+// year digits, two month digits and two day digits, separated by hyphens. No sign, expanded
+// year, basic format, ordinal date or time component is allowed. The month and day must be
+// valid for the year.
+//
+// See https://tools.ietf.org/html/rfc3339#section-5.6
+func ParseRFC3339(value string) (Date, error) {
+	if len(value) != 10 || value[4] != '-' || value[7] != '-' {
+		return 0, fmt.Errorf("date.ParseRFC3339: cannot parse %q: incorrect syntax for full-date yyyy-mm-dd", value)
+	}
+
+	for i := 0; i < len(value); i++ {
+		if i != 4 && i != 7 && (value[i] < '0' || value[i] > '9') {
+			return 0, fmt.Errorf("date.ParseRFC3339: cannot parse %q: incorrect syntax for full-date yyyy-mm-dd", value)
+		}
+	}
+
+	year, _ := strconv.Atoi(value[:4])
+	month, _ := strconv.Atoi(value[5:7])
+	day, _ := strconv.Atoi(value[8:])
+
+	if month < 1 || month > 12 {
+		return 0, fmt.Errorf("date.ParseRFC3339: cannot parse %q: month out of range", value)
+	}
+	if day < 1 || day > gregorian.DaysIn(year, time.Month(month)) {
+		return 0, fmt.Errorf("date.ParseRFC3339: cannot parse %q: day out of range", value)
+	}
+
+	return New(year, time.Month(month), day), nil
+}
+
 func parseISO(input, value string) (Date, error) {
+	d, err := parseISODate(input, value)
+	if err != nil {
+		return 0, fmt.Errorf("date.ParseISO: %w", err)
+	}
+	return d, nil
+}
+
+// parseISODate does the work of parseISO; its errors have no function name prefix, so
+// that the exported function that called it can add its own.
+func parseISODate(input, value string) (Date, error) {
 	abs := value
 	sign := 1
+	signed := false
 
 	if len(value) > 0 {
 		switch value[0] {
 		case '+':
 			abs = value[1:]
+			signed = true
 		case '-':
 			abs = value[1:]
 			sign = -1
+			signed = true
 		}
 	}
 
+	// strip any time before validating the date, whatever the length of the date
 	tee := strings.IndexByte(abs, 'T')
-	if tee == 8 || tee == 10 {
+	if tee > 0 {
 		if !timeRegex1.MatchString(abs[tee:]) && !timeRegex2.MatchString(abs[tee:]) {
-			return 0, fmt.Errorf("date.ParseISO: date-time %q: not a time", value)
+			return 0, fmt.Errorf("date-time %q: not a time", value)
 		}
 		abs = abs[:tee]
+	} else if tee < 0 {
+		var ok bool
+		abs, ok = trimZone(abs)
+		if !ok {
+			return 0, fmt.Errorf("cannot parse %q: malformed zone offset", input)
+		}
+	}
+
+	if w := strings.IndexByte(abs, 'W'); w >= 0 {
+		return parseYYYYWwwD(input, abs[:w], abs[w+1:], sign)
 	}
 
 	dash1 := strings.IndexByte(abs, '-')
 	dash2 := strings.LastIndexByte(abs, '-')
 
 	if dash1 < 0 {
+		if signed && len(abs) == 7 && strings.IndexFunc(abs, isNotDigit) < 0 {
+			// parse ±YYYYOOO, which is too short to be ±YYYYMMDD
+			return parseYYYYOOO(input, abs[:4], abs[4:], sign)
+		}
+
 		// parse YYYYMMDD (more Y digits are allowed)
 		ln := len(abs)
 		fm := ln - 4
 		fd := ln - 2
 		if fm < 0 || fd < 0 {
-			return 0, fmt.Errorf("date.ParseISO: cannot parse %q: too short", input)
+			return 0, fmt.Errorf("cannot parse %q: too short", input)
+		}
+		if ln > 8 && !signed && strings.IndexFunc(abs, isNotDigit) < 0 {
+			return 0, fmt.Errorf("cannot parse %q: ambiguous basic format; a sign is required for years of more than four digits", input)
 		}
 
 		return parseYYYYMMDD(input, abs[:fm], abs[fm:fd], abs[fd:], sign)
@@ -203,7 +495,7 @@ func parseISO(input, value string) (Date, error) {
 		fd1 := dash2 + 1
 
 		if abs[fm2] != '-' {
-			return 0, fmt.Errorf("date.ParseISO: cannot parse %q: incorrect syntax for date yyyy-mm-dd", input)
+			return 0, fmt.Errorf("cannot parse %q: incorrect syntax for date yyyy-mm-dd", input)
 		}
 
 		return parseYYYYMMDD(input, abs[:fy1], abs[fm1:fm2], abs[fd1:], sign)
@@ -214,12 +506,33 @@ func parseISO(input, value string) (Date, error) {
 	fo1 := dash1 + 1
 
 	if len(abs) != fo1+3 {
-		return 0, fmt.Errorf("date.ParseISO: cannot parse %q: incorrect length for ordinal date yyyy-ooo", input)
+		return 0, fmt.Errorf("cannot parse %q: incorrect length for ordinal date yyyy-ooo", input)
 	}
 
 	return parseYYYYOOO(input, abs[:fy1], abs[fo1:], sign)
 }
 
+// trimZone removes a trailing 'Z' or ±HH:MM zone offset from a date that has no time part.
+// It returns false if there is an offset but it is malformed.
+func trimZone(abs string) (string, bool) {
+	if strings.HasSuffix(abs, "Z") {
+		return abs[:len(abs)-1], true
+	}
+
+	i := strings.IndexByte(abs, '+')
+	if i < 0 && strings.IndexByte(abs, ':') >= 0 {
+		i = strings.LastIndexByte(abs, '-')
+	}
+	if i < 0 {
+		return abs, true // no offset
+	}
+
+	if !zoneRegex.MatchString(abs[i:]) {
+		return abs, false
+	}
+	return abs[:i], true
+}
+
 func parseYYYYMMDD(input, yyyy, mm, dd string, sign int) (Date, error) {
 	year, e1 := parseField(yyyy, "year", 4, -1)
 	month, e2 := parseField(mm, "month", -1, 2)
@@ -227,12 +540,10 @@ func parseYYYYMMDD(input, yyyy, mm, dd string, sign int) (Date, error) {
 
 	err := errors.Join(e1, e2, e3)
 	if err != nil {
-		return 0, fmt.Errorf("date.ParseISO: cannot parse %q: %w", input, err)
+		return 0, fmt.Errorf("cannot parse %q: %w", input, err)
 	}
 
-	t := time.Date(sign*year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
-
-	return encode(t), nil
+	return New(sign*year, time.Month(month), day), nil
 }
 
 func parseYYYYOOO(input, yyyy, ooo string, sign int) (Date, error) {
@@ -241,26 +552,93 @@ func parseYYYYOOO(input, yyyy, ooo string, sign int) (Date, error) {
 
 	err := errors.Join(e1, e2)
 	if err != nil {
-		return 0, fmt.Errorf("date.ParseISO: cannot parse ordinal date %q: %w", input, err)
+		return 0, fmt.Errorf("cannot parse ordinal date %q: %w", input, err)
 	}
 
-	t := time.Date(sign*year, time.January, ordinal, 0, 0, 0, 0, time.UTC)
+	return New(sign*year, time.January, ordinal), nil
+}
 
-	return encode(t), nil
+// parseYYYYWwwD parses a week date in either extended (YYYY-Www-D) or basic (YYYYWwwD)
+// format, given the parts before and after the 'W'.
+func parseYYYYWwwD(input, yyyy, wwd string, sign int) (Date, error) {
+	if strings.HasSuffix(yyyy, "-") {
+		yyyy = yyyy[:len(yyyy)-1]
+		if len(wwd) != 4 || wwd[2] != '-' {
+			return 0, fmt.Errorf("cannot parse week date %q: incorrect syntax for week date yyyy-Www-d", input)
+		}
+		wwd = wwd[:2] + wwd[3:]
+	} else if len(wwd) != 3 {
+		return 0, fmt.Errorf("cannot parse week date %q: incorrect syntax for week date yyyyWwwd", input)
+	}
+
+	year, e1 := parseField(yyyy, "year", 4, -1)
+	week, e2 := parseField(wwd[:2], "week", -1, 2)
+	weekday, e3 := parseField(wwd[2:], "weekday", -1, 1)
+
+	year *= sign
+	if e1 == nil && e2 == nil {
+		// 28th December is always in the last week of the year
+		if _, weeks := New(year, time.December, 28).ISOWeek(); week < 1 || week > weeks {
+			e2 = &ParseError{Field: "week", Value: wwd[:2], Err: errOutOfRange}
+		}
+	}
+	if e3 == nil && (weekday < 1 || weekday > 7) {
+		e3 = &ParseError{Field: "weekday", Value: wwd[2:], Err: errOutOfRange}
+	}
+
+	err := errors.Join(e1, e2, e3)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse week date %q: %w", input, err)
+	}
+
+	return isoWeekDate(year, week, weekday), nil
 }
 
 var (
 	timeRegex1 = regexp.MustCompile("^T[0-9][0-9].[0-9][0-9].[0-9][0-9]")
 	timeRegex2 = regexp.MustCompile("^T[0-9]{2,6}")
+	zoneRegex  = regexp.MustCompile("^[+-][0-9][0-9]:[0-9][0-9]$")
 )
 
+// ParseError describes a problem with one field of a date string. The errors returned by
+// ParseISO, ParseISOReduced and ParseOrder wrap a ParseError for each field that is wrong,
+// so the first of them can be obtained using errors.As; for example, a form could use
+// Field to highlight the offending input.
+type ParseError struct {
+	Field string // the name of the field: "year", "month", "day", "ordinal", "week", "weekday" or "quarter"
+	Value string // the text of the field
+	Err   error  // the underlying problem, which may be a *strconv.NumError
+}
+
+var (
+	errWrongLength = errors.New("wrong length")
+	errOutOfRange  = errors.New("out of range")
+)
+
+// Error returns a short description of the problem, e.g. "invalid month".
+func (e *ParseError) Error() string {
+	var ne *strconv.NumError
+	switch {
+	case e.Err == errWrongLength:
+		return e.Field + " has wrong length"
+	case errors.As(e.Err, &ne):
+		return "invalid " + e.Field
+	}
+	return e.Field + " " + e.Err.Error()
+}
+
+// Unwrap returns the underlying problem.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 func parseField(field, name string, minLength, requiredLength int) (int, error) {
 	if (minLength > 0 && len(field) < minLength) || (requiredLength > 0 && len(field) != requiredLength) {
-		return 0, fmt.Errorf("%s has wrong length", name)
+		return 0, &ParseError{Field: name, Value: field, Err: errWrongLength}
 	}
 	number, err := strconv.Atoi(field)
 	if err != nil {
-		return 0, fmt.Errorf("invalid %s", name)
+		return 0, &ParseError{Field: name, Value: field, Err: err}
 	}
 	return number, nil
 }
@@ -291,10 +669,152 @@ func MustParse(layout, value string) Date {
 // This function cannot currently parse ISO 8601 strings that use the expanded
 // year format; you should use date.ParseISO to parse those strings correctly.
 // That is, it only accepts years represented with exactly four digits.
+//
+// If parsing fails, the error mentions the layout and value; the underlying *time.ParseError
+// can be obtained from it using errors.As.
 func Parse(layout, value string) (Date, error) {
 	t, err := time.Parse(layout, value)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("date.Parse: layout %q value %q: %w", layout, value, err)
 	}
 	return encode(t), nil
 }
+
+// ParsePrefix is as per Parse except that the value need only start with a date in the
+// given layout; any text after it is returned unparsed. For example, with the ISO8601
+// layout, "2020-01-02 rest of line" gives 2nd January 2020 and " rest of line". This is
+// useful when scanning lines that begin with a date, such as in log files.
+func ParsePrefix(layout, value string) (Date, string, error) {
+	t, err := time.Parse(layout, value)
+	if err == nil {
+		return encode(t), "", nil
+	}
+
+	// Try successively shorter prefixes, starting from the longest text that the layout
+	// can produce (with long weekday and month names and two-digit days), plus some slack
+	// for elements such as zone names that vary in length. The longest prefix that parses
+	// is the same one that time.Parse would have consumed.
+	longest := len(longestDate.Format(layout)) + 8
+	for n := min(len(value)-1, longest); n > 0; n-- {
+		if t, e := time.Parse(layout, value[:n]); e == nil {
+			return encode(t), value[n:], nil
+		}
+	}
+	return 0, value, fmt.Errorf("date.ParsePrefix: layout %q value %q: %w", layout, value, err)
+}
+
+// longestDate is a Wednesday in September, which have the longest English names.
+var longestDate = time.Date(2006, time.September, 27, 23, 59, 59, 999999999, time.UTC)
+
+// CommonLayouts lists the layouts tried by ParseCommon, in order. The US month-first
+// layout precedes the European day-first layout with the same separator, so a date such
+// as "01/02/2006" is taken to be 2nd January; the European layout only matches when the
+// first field cannot be a month. Applications may alter the list at startup to suit
+// their data, e.g. by removing layouts or changing their order.
+var CommonLayouts = []string{
+	ISO8601,
+	ISO8601B,
+	"01/02/2006", // US
+	"02/01/2006", // European
+	"02.01.2006", // European
+	"02-01-2006", // European
+	RFC1123W,
+	RFC1123,
+	RFC850,
+	RFC822W,
+	RFC822,
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+	"2 Jan 2006",
+}
+
+// ParseCommon parses a date in any of the CommonLayouts, trying each in turn. It
+// returns the date and the layout that matched, so that callers importing data from
+// various sources can record the format that was detected and use it with Parse for
+// subsequent values.
+func ParseCommon(value string) (Date, string, error) {
+	for _, layout := range CommonLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return encode(t), layout, nil
+		}
+	}
+	return 0, "", fmt.Errorf("date.ParseCommon: cannot parse %q: no matching layout", value)
+}
+
+// humanLayouts are tried in order by ParseHuman.
+var humanLayouts = []string{
+	"2 January 2006",
+	"2 Jan 2006",
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"January 2 2006",
+	"Jan 2 2006",
+	"Monday, 2 January 2006",
+	"Mon, 2 Jan 2006",
+	"Monday 2 January 2006",
+	"Mon 2 Jan 2006",
+	"Monday, January 2, 2006",
+	"Mon, Jan 2, 2006",
+	ISO8601,
+}
+
+var ordinalSuffixRegex = regexp.MustCompile(`\b([0-9]{1,2})(?:st|nd|rd|th)\b`)
+
+// ParseHuman parses dates as commonly written by people, such as "3rd February 2013",
+// "February 14th, 2013" or "Mon, 1st Jan 2000". English ordinal suffixes (st, nd, rd, th)
+// are removed from day numbers and runs of whitespace are collapsed; then a set of common
+// layouts with the day before or after the month name is tried in turn. Month and day
+// names may be given in full or abbreviated. Only four-digit years are accepted.
+//
+// Suffixes are only removed when they directly follow one or two digits, so words such
+// as "August" are unaffected. The suffix is not checked against the number, so "3th" is
+// accepted as the third.
+func ParseHuman(value string) (Date, error) {
+	s := strings.Join(strings.Fields(value), " ")
+	s = ordinalSuffixRegex.ReplaceAllString(s, "$1")
+	for _, layout := range humanLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return encode(t), nil
+		}
+	}
+	return 0, fmt.Errorf("date.ParseHuman: cannot parse %q", value)
+}
+
+// ParseCompact parses dates written without separators around a three-letter English month
+// abbreviation, as found in some log files. Both day-first "14Feb2013" and year-first
+// "2013Feb14" orders are accepted; the year must have exactly four digits and the day has
+// one or two digits, which is what makes the order unambiguous. The month abbreviation is
+// not case-sensitive, so "14feb2013" and "14FEB2013" are also accepted. Surrounding
+// whitespace is ignored.
+func ParseCompact(value string) (Date, error) {
+	s := strings.TrimSpace(value)
+	i := strings.IndexFunc(s, isNotDigit)
+	if i < 0 || i+3 > len(s) {
+		return 0, fmt.Errorf("date.ParseCompact: cannot parse %q: incorrect syntax", value)
+	}
+
+	month := time.Month(0)
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(s[i:i+3], m.String()[:3]) {
+			month = m
+			break
+		}
+	}
+	if month == 0 || (i+3 < len(s) && unicode.IsLetter(rune(s[i+3]))) {
+		return 0, fmt.Errorf("date.ParseCompact: cannot parse %q: unknown month", value)
+	}
+
+	before, after := s[:i], s[i+3:]
+	yyyy, dd := before, after
+	if len(before) < 4 {
+		yyyy, dd = after, before
+	}
+
+	year, e1 := parseField(yyyy, "year", -1, 4)
+	day, e2 := parseShortField(dd, "day", gregorian.DaysIn(year, month))
+	if err := errors.Join(e1, e2); err != nil {
+		return 0, fmt.Errorf("date.ParseCompact: cannot parse %q: %w", value, err)
+	}
+	return New(year, month, day), nil
+}
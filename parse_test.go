@@ -5,7 +5,9 @@
 package date
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"testing"
 	time "time"
 )
@@ -46,12 +48,18 @@ func TestAutoParse_both(t *testing.T) {
 		{value: "12340506", year: 1234, month: time.May, day: 6},
 		{value: "+12340506", year: 1234, month: time.May, day: 6},
 		{value: "-00191012", year: -19, month: time.October, day: 12},
+		{value: "+202000102", year: 20200, month: time.January, day: 2},
+		{value: "-123451231", year: -12345, month: time.December, day: 31},
 		{value: " -00191012 ", year: -19, month: time.October, day: 12},
 		// yyyy-ooo ordinal cases
 		{value: "2004-001", year: 2004, month: time.January, day: 1},
 		{value: "2004-060", year: 2004, month: time.February, day: 29},
 		{value: "2004-366", year: 2004, month: time.December, day: 31},
 		{value: "2003-365", year: 2003, month: time.December, day: 31},
+		{value: "2006-217T12:00:00Z", year: 2006, month: time.August, day: 5},
+		{value: "+12006-217T12:00:00Z", year: 12006, month: time.August, day: 5},
+		{value: "-0001-001T000000", year: -1, month: time.January, day: 1},
+		{value: "+12006-01-02T12:00:00Z", year: 12006, month: time.January, day: 2},
 		// basic format is only supported for yyyymmdd (yyyyooo ordinal is not supported)
 		{value: "12340506", year: 1234, month: time.May, day: 6},
 		{value: "+12340506", year: 1234, month: time.May, day: 6},
@@ -83,6 +91,13 @@ func TestAutoParse(t *testing.T) {
 	}{
 		{value: " 31/12/1969 ", year: 1969, month: time.December, day: 31},
 		{value: " 5/6/1905 ", year: 1905, month: time.June, day: 5},
+		{value: "2.1.20", year: 2020, month: time.January, day: 2},
+		{value: "02.01.2020", year: 2020, month: time.January, day: 2},
+		{value: "2020.1.2", year: 2020, month: time.January, day: 2},
+		{value: "2020/12/3", year: 2020, month: time.December, day: 3},
+		{value: "31/12/68", year: 2068, month: time.December, day: 31},
+		{value: "1/1/69", year: 1969, month: time.January, day: 1},
+		{value: "1.1.00", year: 2000, month: time.January, day: 1},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
@@ -104,6 +119,8 @@ func TestAutoParseUS(t *testing.T) {
 	}{
 		{value: " 12/31/1969 ", year: 1969, month: time.December, day: 31},
 		{value: " 6/5/1905 ", year: 1905, month: time.June, day: 5},
+		{value: "1.2.20", year: 2020, month: time.January, day: 2},
+		{value: "2020.1.2", year: 2020, month: time.January, day: 2},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
@@ -116,6 +133,88 @@ func TestAutoParseUS(t *testing.T) {
 	}
 }
 
+func TestAutoParse_TwoDigitYearPivot(t *testing.T) {
+	if d := MustAutoParse("1.2.68"); d != New(2068, time.February, 1) {
+		t.Errorf("got %v", d)
+	}
+	if d := MustAutoParse("1.2.69"); d != New(1969, time.February, 1) {
+		t.Errorf("got %v", d)
+	}
+	if d, err := AutoParseWithPivot("1.2.29", 30); err != nil || d != New(2029, time.February, 1) {
+		t.Errorf("got %v, %v", d, err)
+	}
+	if d, err := AutoParseWithPivot("1.2.30", 30); err != nil || d != New(1930, time.February, 1) {
+		t.Errorf("got %v, %v", d, err)
+	}
+	if d, err := AutoParseWithPivot("2020-06-15", 30); err != nil || d != New(2020, time.June, 15) {
+		t.Errorf("got %v, %v", d, err)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	cases := []struct {
+		s, sep string
+		want   string
+	}{
+		{s: "2020-01-01,2020-06-15,2020-12-31", sep: ",", want: "[2020-01-01 2020-06-15 2020-12-31]"},
+		{s: " 2020-01-01 , 15/06/2020 ,\t2020-12-31, ", sep: ",", want: "[2020-01-01 2020-06-15 2020-12-31]"},
+		{s: "2020-01-01;;2020-06-15", sep: ";", want: "[2020-01-01 2020-06-15]"},
+		{s: "2020-01-01", sep: ",", want: "[2020-01-01]"},
+		{s: "", sep: ",", want: "[]"},
+	}
+	for i, c := range cases {
+		list, err := ParseList(c.s, c.sep)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+		if fmt.Sprint(list) != c.want {
+			t.Errorf("%d: got %v, want %s", i, list, c.want)
+		}
+	}
+}
+
+func TestParseList_errors(t *testing.T) {
+	_, err := ParseList("2020-01-01,,2020-06-15, 2020-13-45x ,2020-12-31", ",")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	want := `date.ParseList: element 3: date.ParseISO: cannot parse "2020-13-45x": day has wrong length`
+	if err.Error() != want {
+		t.Errorf("got %s\nwant %s", err, want)
+	}
+}
+
+func TestAutoParseSep(t *testing.T) {
+	want := New(2020, time.January, 2)
+	good := []string{"02/01/2020", "2.1.2020", "2020-01-02", "2020/1/2", " 2/1/20 ", "2020-01-02T10:20:30Z"}
+	for i, c := range good {
+		d, err := AutoParseSep(c, "/.-")
+		if err != nil || d != want {
+			t.Errorf("%d: AutoParseSep(%q) == %v, %v", i, c, d, err)
+		}
+	}
+
+	bad := []struct{ value, seps string }{
+		{value: "02'01'2020", seps: "/.-"},
+		{value: "02/01/2020'", seps: "/.-"},
+		{value: `"02/01/2020"`, seps: "/.-"},
+		{value: "02_01_2020", seps: "/.-"},
+		{value: "2020-01-02", seps: "/"},
+		{value: "02.01.2020", seps: "/"},
+	}
+	for i, c := range bad {
+		d, err := AutoParseSep(c.value, c.seps)
+		if err == nil {
+			t.Errorf("%d: AutoParseSep(%q, %q) == %v", i, c.value, c.seps, d)
+		}
+	}
+
+	_, err := AutoParseSep("02'01'2020", "/.-")
+	if err == nil || err.Error() != `date.AutoParseSep: cannot parse "02'01'2020": separator '\'' is not allowed` {
+		t.Errorf("got %v", err)
+	}
+}
+
 func TestAutoParse_errors(t *testing.T) {
 	badCases := []string{
 		"1234-05",
@@ -133,6 +232,9 @@ func TestAutoParse_errors(t *testing.T) {
 		"+10-11-12",
 		"+100-02-03",
 		"-123-05-06",
+		"2.1.2",
+		"2.1.202",
+		"+2.1.20",
 		"20210506T0Z",
 		"2021-05-06T0:0:0Z",
 		"--",
@@ -194,6 +296,13 @@ func TestParseISO(t *testing.T) {
 		{value: "+12340506", year: 1234, month: time.May, day: 6},
 		{value: "-00191012", year: -19, month: time.October, day: 12},
 		{value: "20210506T010203Z", year: 2021, month: time.May, day: 6},
+		// zone marker without a time
+		{value: "2020-01-02Z", year: 2020, month: time.January, day: 2},
+		{value: "2020-01-02+05:30", year: 2020, month: time.January, day: 2},
+		{value: "2020-01-02-08:00", year: 2020, month: time.January, day: 2},
+		{value: "-0019-01-02+00:00", year: -19, month: time.January, day: 2},
+		{value: "20200102Z", year: 2020, month: time.January, day: 2},
+		{value: "2020-002Z", year: 2020, month: time.January, day: 2},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
@@ -206,6 +315,329 @@ func TestParseISO(t *testing.T) {
 	}
 }
 
+func TestParseISO_year_digits(t *testing.T) {
+	cases := []struct {
+		value string
+		year  int
+		ok    bool
+	}{
+		{value: "215-08-15"},
+		{value: "0215-08-15", year: 215, ok: true},
+		{value: "00215-08-15", year: 215, ok: true},
+		{value: "000215-08-15", year: 215, ok: true},
+		{value: "2015-08-15", year: 2015, ok: true},
+		{value: "02015-08-15", year: 2015, ok: true},
+		{value: "+215-08-15"},
+		{value: "+0215-08-15", year: 215, ok: true},
+		{value: "+00215-08-15", year: 215, ok: true},
+		{value: "-215-08-15"},
+		{value: "-0215-08-15", year: -215, ok: true},
+		{value: "-00215-08-15", year: -215, ok: true},
+		{value: "215-227"},
+		{value: "0215-227", year: 215, ok: true},
+		{value: "00215-227", year: 215, ok: true},
+	}
+	for i, c := range cases {
+		d, err := ParseISO(c.value)
+		if c.ok {
+			if err != nil || d.Year() != c.year {
+				t.Errorf("%d: ParseISO(%q) == %v, %v; want year %d", i, c.value, d, err, c.year)
+			}
+		} else if err == nil {
+			t.Errorf("%d: ParseISO(%q) == %v; want an error", i, c.value, d)
+		}
+	}
+}
+
+func TestParseISO_week_dates(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Date
+	}{
+		{value: "2018-W01-1", want: New(2018, time.January, 1)},
+		{value: "2018W011", want: New(2018, time.January, 1)},
+		{value: "2018-W01-1T10:20:30Z", want: New(2018, time.January, 1)},
+		{value: "2018W011T102030", want: New(2018, time.January, 1)},
+		{value: "2018-W01-1Z", want: New(2018, time.January, 1)},
+		{value: "2019-W01-1", want: New(2018, time.December, 31)},
+		{value: "2020W537", want: New(2021, time.January, 3)},
+		{value: "2015-W53-5", want: New(2016, time.January, 1)},
+		{value: "2009-W53-7T00:00:00+01:00", want: New(2010, time.January, 3)},
+		{value: "+12345-W23-4", want: New(12345, time.June, 7)},
+		{value: "-0001-W01-1", want: New(-1, time.January, 4)},
+	}
+	for i, c := range cases {
+		d, err := ParseISO(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseISO(%q) error %v", i, c.value, err)
+		} else if d != c.want {
+			t.Errorf("%d: ParseISO(%q) == %v, want %v", i, c.value, d, c.want)
+		}
+	}
+
+	for d := New(1999, time.December, 1); d < New(2030, time.January, 31); d++ {
+		year, week := d.ISOWeek()
+		basic := fmt.Sprintf("%04dW%02d%d", year, week, d.ISOWeekday())
+		extended := fmt.Sprintf("%04d-W%02d-%d", year, week, d.ISOWeekday())
+		if r, err := ParseISO(basic); err != nil || r != d {
+			t.Fatalf("ParseISO(%q) == %v, %v; want %v", basic, r, err, d)
+		}
+		if r, err := ParseISO(extended); err != nil || r != d {
+			t.Fatalf("ParseISO(%q) == %v, %v; want %v", extended, r, err, d)
+		}
+	}
+}
+
+func TestParseISO_week_date_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{value: "2018-W53-1", want: `date.ParseISO: cannot parse week date "2018-W53-1": week out of range`},
+		{value: "2018W001", want: `date.ParseISO: cannot parse week date "2018W001": week out of range`},
+		{value: "2018-W01-8", want: `date.ParseISO: cannot parse week date "2018-W01-8": weekday out of range`},
+		{value: "2018W010", want: `date.ParseISO: cannot parse week date "2018W010": weekday out of range`},
+		{value: "2018-W011", want: `date.ParseISO: cannot parse week date "2018-W011": incorrect syntax for week date yyyy-Www-d`},
+		{value: "2018-W01", want: `date.ParseISO: cannot parse week date "2018-W01": incorrect syntax for week date yyyy-Www-d`},
+		{value: "2018W01-1", want: `date.ParseISO: cannot parse week date "2018W01-1": incorrect syntax for week date yyyyWwwd`},
+		{value: "2018W0111", want: `date.ParseISO: cannot parse week date "2018W0111": incorrect syntax for week date yyyyWwwd`},
+		{value: "218-W01-1", want: `date.ParseISO: cannot parse week date "218-W01-1": year has wrong length`},
+		{value: "2018-Wx1-y", want: `date.ParseISO: cannot parse week date "2018-Wx1-y": ` + "invalid week\ninvalid weekday"},
+		{value: "2018-W01-1T1", want: `date.ParseISO: date-time "2018-W01-1T1": not a time`},
+	}
+	for i, c := range cases {
+		_, err := ParseISO(c.value)
+		if err == nil {
+			t.Errorf("%d: ParseISO(%q) expected an error", i, c.value)
+		} else if err.Error() != c.want {
+			t.Errorf("%d: ParseISO(%q) error %q, want %q", i, c.value, err.Error(), c.want)
+		}
+	}
+}
+
+func TestParseISO_signed_basic_ordinal(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Date
+	}{
+		{value: "+2006217", want: New(2006, time.August, 5)},
+		{value: "-0752112", want: New(-752, time.April, 21)},
+		{value: "+2006217T10:20:30Z", want: New(2006, time.August, 5)},
+		{value: "+2024366", want: New(2024, time.December, 31)},
+		{value: "20060217", want: New(2006, time.February, 17)},
+		{value: "+20060217", want: New(2006, time.February, 17)},
+		{value: "2006-217", want: New(2006, time.August, 5)},
+	}
+	for i, c := range cases {
+		d, err := ParseISO(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseISO(%q) error %v", i, c.value, err)
+		} else if d != c.want {
+			t.Errorf("%d: ParseISO(%q) == %v, want %v", i, c.value, d, c.want)
+		}
+	}
+
+	for _, d := range []Date{New(2006, time.August, 5), New(-752, time.April, 21), New(2024, time.December, 31)} {
+		s := d.FormatOrdinalISO(4) // e.g. "+2006-217"
+		s = s[:5] + s[6:]
+		if r, err := ParseISO(s); err != nil || r != d {
+			t.Errorf("ParseISO(%q) == %v, %v; want %v", s, r, err, d)
+		}
+	}
+
+	if _, err := ParseISO("+2006x17"); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestParseISOLenient(t *testing.T) {
+	want := New(2020, time.January, 2)
+	cases := []string{
+		`2020-01-02`,
+		`"2020-01-02"`,
+		`'2020-01-02'`,
+		"  2020-01-02\n",
+		` "2020-01-02" `,
+		"\t'20200102'  ",
+	}
+	for i, c := range cases {
+		d, err := ParseISOLenient(c)
+		if err != nil || d != want {
+			t.Errorf("%d: ParseISOLenient(%q) == %v, %v", i, c, d, err)
+		}
+	}
+
+	bad := []string{
+		`"2020-01-02'`,
+		`""2020-01-02""`,
+		`" 2020-01-02 "`,
+		`"`,
+		``,
+	}
+	for i, c := range bad {
+		d, err := ParseISOLenient(c)
+		if err == nil {
+			t.Errorf("%d: ParseISOLenient(%q) == %v", i, c, d)
+		}
+	}
+
+	// the strict parser is unchanged
+	if _, err := ParseISO(`"2020-01-02"`); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestParseISOLax(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Date
+	}{
+		{value: "2020-1-2", want: New(2020, time.January, 2)},
+		{value: "2020-01-2", want: New(2020, time.January, 2)},
+		{value: "2020-1-02", want: New(2020, time.January, 2)},
+		{value: "2020-01-02", want: New(2020, time.January, 2)},
+		{value: "2020-12-9", want: New(2020, time.December, 9)},
+		{value: "2020-1-2T10:20:30Z", want: New(2020, time.January, 2)},
+		{value: "+12345-6-7", want: New(12345, time.June, 7)},
+		{value: "-0001-1-2", want: New(-1, time.January, 2)},
+		{value: "20200102", want: New(2020, time.January, 2)},
+		{value: "2020-002", want: New(2020, time.January, 2)},
+	}
+	for i, c := range cases {
+		d, err := ParseISOLax(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseISOLax(%q) error %v", i, c.value, err)
+		} else if d != c.want {
+			t.Errorf("%d: ParseISOLax(%q) == %v, want %v", i, c.value, d, c.want)
+		}
+	}
+
+	bad := []string{
+		"",
+		"2020--2",
+		"2020-1-",
+		"2020--",
+		"2020-123-4",
+		"2020-1-234",
+		"202-1-2",
+		"2020-x-2",
+	}
+	for i, c := range bad {
+		d, err := ParseISOLax(c)
+		if err == nil {
+			t.Errorf("%d: ParseISOLax(%q) == %v, want an error", i, c, d)
+		}
+	}
+
+	// ParseISO itself remains strict
+	if _, err := ParseISO("2020-1-2"); err == nil {
+		t.Errorf("ParseISO should reject one-digit fields")
+	}
+}
+
+func TestParseISOReduced(t *testing.T) {
+	cases := []struct {
+		value     string
+		want      Date
+		precision Precision
+	}{
+		{value: "2020", want: New(2020, time.January, 1), precision: YearPrecision},
+		{value: "2020-06", want: New(2020, time.June, 1), precision: MonthPrecision},
+		{value: "2020-06-15", want: New(2020, time.June, 15), precision: DayPrecision},
+		{value: "20200615", want: New(2020, time.June, 15), precision: DayPrecision},
+		{value: "2020-167", want: New(2020, time.June, 15), precision: DayPrecision},
+		{value: "0000", want: New(0, time.January, 1), precision: YearPrecision},
+		{value: "+12345", want: New(12345, time.January, 1), precision: YearPrecision},
+		{value: "-0752", want: New(-752, time.January, 1), precision: YearPrecision},
+		{value: "+12345-12", want: New(12345, time.December, 1), precision: MonthPrecision},
+		{value: "-0752-04", want: New(-752, time.April, 1), precision: MonthPrecision},
+		{value: "+20200615", want: New(2020, time.June, 15), precision: DayPrecision},
+	}
+	for i, c := range cases {
+		d, p, err := ParseISOReduced(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseISOReduced(%q) error %v", i, c.value, err)
+		} else if d != c.want || p != c.precision {
+			t.Errorf("%d: ParseISOReduced(%q) == %v, %v, want %v, %v", i, c.value, d, p, c.want, c.precision)
+		}
+	}
+}
+
+func TestParseISOReduced_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: `date.ParseISOReduced: cannot parse "": too short`},
+		{value: "202", want: `date.ParseISOReduced: cannot parse "202": too short`},
+		{value: "20x0", want: `date.ParseISOReduced: cannot parse "20x0": invalid year`},
+		{value: "2020-13", want: `date.ParseISOReduced: cannot parse "2020-13": month out of range`},
+		{value: "2020-00", want: `date.ParseISOReduced: cannot parse "2020-00": month out of range`},
+		{value: "202-06", want: `date.ParseISOReduced: cannot parse "202-06": year has wrong length`},
+		{value: "2020-6", want: `date.ParseISOReduced: cannot parse "2020-6": incorrect length for ordinal date yyyy-ooo`},
+		{value: "202006", want: `date.ParseISOReduced: cannot parse "202006": year has wrong length`},
+	}
+	for i, c := range cases {
+		_, _, err := ParseISOReduced(c.value)
+		if err == nil {
+			t.Errorf("%d: ParseISOReduced(%q) expected an error", i, c.value)
+		} else if err.Error() != c.want {
+			t.Errorf("%d: ParseISOReduced(%q) error %q, want %q", i, c.value, err.Error(), c.want)
+		}
+	}
+}
+
+func TestParseError_fields(t *testing.T) {
+	cases := []struct {
+		value string
+		field string
+		text  string
+		msg   string
+	}{
+		{value: "20x0-01-02", field: "year", text: "20x0", msg: "invalid year"},
+		{value: "202-01-02", field: "year", text: "202", msg: "year has wrong length"},
+		{value: "2020-1x-02", field: "month", text: "1x", msg: "invalid month"},
+		{value: "2020-001-02", field: "month", text: "001", msg: "month has wrong length"},
+		{value: "2020-01-x2", field: "day", text: "x2", msg: "invalid day"},
+		{value: "2020-01-2", field: "day", text: "2", msg: "day has wrong length"},
+		{value: "2020-0x1", field: "ordinal", text: "0x1", msg: "invalid ordinal"},
+		{value: "2020-0x1-0y", field: "month", text: "0x1", msg: "month has wrong length"},
+	}
+	for i, c := range cases {
+		_, err := ParseISO(c.value)
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%d: %v does not wrap a *ParseError", i, err)
+			continue
+		}
+		if pe.Field != c.field || pe.Value != c.text || pe.Error() != c.msg {
+			t.Errorf("%d: got %+v (%q), want %s %q %q", i, pe, pe.Error(), c.field, c.text, c.msg)
+		}
+	}
+
+	_, _, err := ParseISOReduced("2020-13")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Field != "month" || pe.Value != "13" {
+		t.Errorf("got %v", err)
+	}
+
+	_, err = ParseOrder("2020/13/01", YMD)
+	if !errors.As(err, &pe) || pe.Field != "month" || pe.Value != "13" || pe.Error() != "month out of range" {
+		t.Errorf("got %v", err)
+	}
+
+	_, err = ParseOrder("2021/02/30", YMD)
+	if !errors.As(err, &pe) || pe.Field != "day" || pe.Value != "30" || pe.Error() != "day out of range" {
+		t.Errorf("got %v", err)
+	}
+
+	var ne *strconv.NumError
+	_, err = ParseISO("2020-01-x2")
+	if !errors.As(err, &ne) {
+		t.Errorf("%v does not wrap a *strconv.NumError", err)
+	}
+}
+
 func TestParseISO_errors(t *testing.T) {
 	cases := []struct {
 		value string
@@ -236,6 +668,13 @@ func TestParseISO_errors(t *testing.T) {
 		{value: "-123-05-06", want: `date.ParseISO: cannot parse "-123-05-06": year has wrong length`},
 		{value: "2018-02-03T0:0:0Z", want: `date.ParseISO: date-time "2018-02-03T0:0:0Z": not a time`},
 		{value: "2018-02-03T0Z", want: `date.ParseISO: date-time "2018-02-03T0Z": not a time`},
+		{value: "2006-217T1", want: `date.ParseISO: date-time "2006-217T1": not a time`},
+		{value: "2006-2177T12:00", want: `date.ParseISO: cannot parse "2006-2177T12:00": incorrect length for ordinal date yyyy-ooo`},
+		{value: "202000102", want: `date.ParseISO: cannot parse "202000102": ambiguous basic format; a sign is required for years of more than four digits`},
+		{value: "0020200102T000000", want: `date.ParseISO: cannot parse "0020200102T000000": ambiguous basic format; a sign is required for years of more than four digits`},
+		{value: "2020-01-02+5", want: `date.ParseISO: cannot parse "2020-01-02+5": malformed zone offset`},
+		{value: "2020-01-02+05:3", want: `date.ParseISO: cannot parse "2020-01-02+05:3": malformed zone offset`},
+		{value: "2020-01-02-5:30", want: `date.ParseISO: cannot parse "2020-01-02-5:30": malformed zone offset`},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
@@ -250,6 +689,67 @@ func TestParseISO_errors(t *testing.T) {
 	}
 }
 
+func TestParseRFC3339(t *testing.T) {
+	cases := []struct {
+		value string
+		year  int
+		month time.Month
+		day   int
+	}{
+		{value: "0000-01-01", month: time.January, day: 1},
+		{value: "1969-12-31", year: 1969, month: time.December, day: 31},
+		{value: "2004-02-29", year: 2004, month: time.February, day: 29},
+		{value: "9999-12-31", year: 9999, month: time.December, day: 31},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
+			d, err := ParseRFC3339(c.value)
+			if err != nil {
+				t.Fatalf("ParseRFC3339(%v) unexpected error %v", c.value, err)
+			}
+			year, month, day := d.Date()
+			if year != c.year || month != c.month || day != c.day {
+				t.Errorf("ParseRFC3339(%v) == %v, want (%v, %v, %v)", c.value, d, c.year, c.month, c.day)
+			}
+		})
+	}
+}
+
+func TestParseRFC3339_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{value: ``, want: `date.ParseRFC3339: cannot parse "": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-1-2`, want: `date.ParseRFC3339: cannot parse "2020-1-2": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `+2020-01-02`, want: `date.ParseRFC3339: cannot parse "+2020-01-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `-2020-01-02`, want: `date.ParseRFC3339: cannot parse "-2020-01-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `12020-01-02`, want: `date.ParseRFC3339: cannot parse "12020-01-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `20200102`, want: `date.ParseRFC3339: cannot parse "20200102": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-002`, want: `date.ParseRFC3339: cannot parse "2020-002": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-01-02T00:00:00Z`, want: `date.ParseRFC3339: cannot parse "2020-01-02T00:00:00Z": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: ` 2020-01-02`, want: `date.ParseRFC3339: cannot parse " 2020-01-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020/01/02`, want: `date.ParseRFC3339: cannot parse "2020/01/02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-0A-02`, want: `date.ParseRFC3339: cannot parse "2020-0A-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-+1-02`, want: `date.ParseRFC3339: cannot parse "2020-+1-02": incorrect syntax for full-date yyyy-mm-dd`},
+		{value: `2020-00-02`, want: `date.ParseRFC3339: cannot parse "2020-00-02": month out of range`},
+		{value: `2020-13-02`, want: `date.ParseRFC3339: cannot parse "2020-13-02": month out of range`},
+		{value: `2020-01-00`, want: `date.ParseRFC3339: cannot parse "2020-01-00": day out of range`},
+		{value: `2021-02-29`, want: `date.ParseRFC3339: cannot parse "2021-02-29": day out of range`},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
+			d, err := ParseRFC3339(c.value)
+			if err == nil {
+				t.Fatalf("ParseRFC3339(%v) == %v", c.value, d)
+			}
+			if err.Error() != c.want {
+				t.Errorf("got %s\nwant %s", err.Error(), c.want)
+			}
+		})
+	}
+}
+
 func BenchmarkParseISO(b *testing.B) {
 	cases := []struct {
 		layout string
@@ -326,6 +826,211 @@ func TestParse_errors(t *testing.T) {
 	}
 }
 
+func TestParse_error_wrapping(t *testing.T) {
+	_, err := Parse(ISO8601, "2020-13-01")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := `date.Parse: layout "2006-01-02" value "2020-13-01": parsing time "2020-13-01": month out of range`
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+
+	var pe *time.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("%v does not wrap a *time.ParseError", err)
+	}
+	if pe.Value != "2020-13-01" {
+		t.Errorf("got %+v", pe)
+	}
+	if !errors.Is(err, pe) || errors.Unwrap(err) != error(pe) {
+		t.Errorf("%v does not unwrap to %v", err, pe)
+	}
+}
+
+func TestParsePrefix(t *testing.T) {
+	cases := []struct {
+		layout string
+		value  string
+		want   Date
+		rest   string
+	}{
+		{layout: ISO8601, value: "2020-01-02 rest of line", want: New(2020, time.January, 2), rest: " rest of line"},
+		{layout: ISO8601, value: "2020-01-02", want: New(2020, time.January, 2), rest: ""},
+		{layout: ISO8601, value: "2020-01-02T15:04:05Z", want: New(2020, time.January, 2), rest: "T15:04:05Z"},
+		{layout: RFC1123W, value: "Thu, 02 Jan 2020: started", want: New(2020, time.January, 2), rest: ": started"},
+		{layout: "02/01/2006", value: "02/01/20201", want: New(2020, time.January, 2), rest: "1"},
+		{layout: "2 Jan 2006", value: "2 Jan 2020 2 Jan 2021", want: New(2020, time.January, 2), rest: " 2 Jan 2021"},
+		{layout: "Monday, January 2, 2006", value: "Wednesday, September 30, 2020, 9am", want: New(2020, time.September, 30), rest: ", 9am"},
+		{layout: "Mon Jan _2 2006", value: "Wed Sep  2 2020!", want: New(2020, time.September, 2), rest: "!"},
+	}
+	for i, c := range cases {
+		d, rest, err := ParsePrefix(c.layout, c.value)
+		if err != nil {
+			t.Errorf("%d: ParsePrefix(%q, %q) error %v", i, c.layout, c.value, err)
+		} else if d != c.want || rest != c.rest {
+			t.Errorf("%d: ParsePrefix(%q, %q) == %v, %q; want %v, %q", i, c.layout, c.value, d, rest, c.want, c.rest)
+		}
+	}
+}
+
+func TestParsePrefix_errors(t *testing.T) {
+	cases := []struct {
+		layout string
+		value  string
+	}{
+		{layout: ISO8601, value: ""},
+		{layout: ISO8601, value: "2020-01"},
+		{layout: ISO8601, value: "2020-13-02 rest"},
+		{layout: ISO8601, value: "x2020-01-02"},
+	}
+	for i, c := range cases {
+		d, rest, err := ParsePrefix(c.layout, c.value)
+		if err == nil {
+			t.Errorf("%d: ParsePrefix(%q, %q) == %v, %q", i, c.layout, c.value, d, rest)
+		} else if rest != c.value {
+			t.Errorf("%d: ParsePrefix(%q, %q) rest %q", i, c.layout, c.value, rest)
+		}
+	}
+
+	var pe *time.ParseError
+	if _, _, err := ParsePrefix(ISO8601, "2020-13-02 rest"); !errors.As(err, &pe) {
+		t.Errorf("%v does not wrap a *time.ParseError", err)
+	}
+}
+
+func TestParseCommon(t *testing.T) {
+	cases := []struct {
+		value  string
+		want   Date
+		layout string
+	}{
+		{value: "2020-01-02", want: New(2020, time.January, 2), layout: ISO8601},
+		{value: "20200102", want: New(2020, time.January, 2), layout: ISO8601B},
+		{value: "01/02/2020", want: New(2020, time.January, 2), layout: "01/02/2006"},
+		{value: "13/02/2020", want: New(2020, time.February, 13), layout: "02/01/2006"},
+		{value: "13.02.2020", want: New(2020, time.February, 13), layout: "02.01.2006"},
+		{value: "Thu, 02 Jan 2020", want: New(2020, time.January, 2), layout: RFC1123W},
+		{value: "02 Jan 2020", want: New(2020, time.January, 2), layout: RFC1123},
+		{value: "Thursday, 02-Jan-20", want: New(2020, time.January, 2), layout: RFC850},
+		{value: "02-Jan-20", want: New(2020, time.January, 2), layout: RFC822},
+		{value: "January 2, 2020", want: New(2020, time.January, 2), layout: "January 2, 2006"},
+		{value: "2 Jan 2020", want: New(2020, time.January, 2), layout: "2 Jan 2006"},
+	}
+	for i, c := range cases {
+		d, layout, err := ParseCommon(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseCommon(%q) error %v", i, c.value, err)
+		} else if d != c.want || layout != c.layout {
+			t.Errorf("%d: ParseCommon(%q) == %v, %q; want %v, %q", i, c.value, d, layout, c.want, c.layout)
+		} else if r := MustParse(layout, c.value); r != d {
+			t.Errorf("%d: Parse(%q, %q) == %v", i, layout, c.value, r)
+		}
+	}
+
+	for i, c := range []string{"", "2020-13-02", "32/13/2020", "yesterday"} {
+		if _, _, err := ParseCommon(c); err == nil {
+			t.Errorf("%d: ParseCommon(%q) expected an error", i, c)
+		}
+	}
+}
+
+func TestParseHuman(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Date
+	}{
+		{value: "3rd February 2013", want: New(2013, time.February, 3)},
+		{value: "February 14th, 2013", want: New(2013, time.February, 14)},
+		{value: "1st Jan 2000", want: New(2000, time.January, 1)},
+		{value: "22nd August 1999", want: New(1999, time.August, 22)},
+		{value: "Aug 11th 1999", want: New(1999, time.August, 11)},
+		{value: "Sat, 1st Jan 2000", want: New(2000, time.January, 1)},
+		{value: "Thursday, 21st  August 2014", want: New(2014, time.August, 21)},
+		{value: "  5 March 2020 ", want: New(2020, time.March, 5)},
+		{value: "2020-03-05", want: New(2020, time.March, 5)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c.value), func(t *testing.T) {
+			d, err := ParseHuman(c.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != c.want {
+				t.Errorf("ParseHuman(%q) == %v, want %v", c.value, d, c.want)
+			}
+		})
+	}
+}
+
+func TestParseHuman_errors(t *testing.T) {
+	cases := []string{
+		"",
+		"3rd",
+		"31st February 2013",
+		"1st Augst 2000",
+		"February 2013",
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c), func(t *testing.T) {
+			d, err := ParseHuman(c)
+			if err == nil {
+				t.Errorf("ParseHuman(%q) == %v", c, d)
+			}
+		})
+	}
+}
+
+func TestParseCompact(t *testing.T) {
+	cases := []struct {
+		value string
+		want  Date
+	}{
+		{value: "14Feb2013", want: New(2013, time.February, 14)},
+		{value: "2013Feb14", want: New(2013, time.February, 14)},
+		{value: "14feb2013", want: New(2013, time.February, 14)},
+		{value: "2013FEB14", want: New(2013, time.February, 14)},
+		{value: "1Jan2000", want: New(2000, time.January, 1)},
+		{value: "2000dec1", want: New(2000, time.December, 1)},
+		{value: "29Feb2000", want: New(2000, time.February, 29)},
+		{value: " 31Oct1999\n", want: New(1999, time.October, 31)},
+	}
+	for i, c := range cases {
+		d, err := ParseCompact(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseCompact(%q) error %v", i, c.value, err)
+		} else if d != c.want {
+			t.Errorf("%d: ParseCompact(%q) == %v, want %v", i, c.value, d, c.want)
+		}
+	}
+}
+
+func TestParseCompact_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{value: "", want: `date.ParseCompact: cannot parse "": incorrect syntax`},
+		{value: "20130214", want: `date.ParseCompact: cannot parse "20130214": incorrect syntax`},
+		{value: "14Fe", want: `date.ParseCompact: cannot parse "14Fe": incorrect syntax`},
+		{value: "14Fab2013", want: `date.ParseCompact: cannot parse "14Fab2013": unknown month`},
+		{value: "14Feb13", want: `date.ParseCompact: cannot parse "14Feb13": year has wrong length`},
+		{value: "2013Feb2013", want: `date.ParseCompact: cannot parse "2013Feb2013": day has wrong length`},
+		{value: "29Feb2013", want: `date.ParseCompact: cannot parse "29Feb2013": day out of range`},
+		{value: "0Feb2013", want: `date.ParseCompact: cannot parse "0Feb2013": day out of range`},
+		{value: "14February2013", want: `date.ParseCompact: cannot parse "14February2013": unknown month`},
+	}
+	for i, c := range cases {
+		_, err := ParseCompact(c.value)
+		if err == nil {
+			t.Errorf("%d: ParseCompact(%q) expected an error", i, c.value)
+		} else if err.Error() != c.want {
+			t.Errorf("%d: ParseCompact(%q) error %q, want %q", i, c.value, err.Error(), c.want)
+		}
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	// Test ability to parse a few common date formats
 	cases := []struct {
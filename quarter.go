@@ -0,0 +1,66 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Quarter returns the quarter of the year in which d falls, from 1 (January to March)
+// to 4 (October to December).
+func (d Date) Quarter() int {
+	return int(d.Month()-1)/3 + 1
+}
+
+// QuarterString returns the year and quarter in which d falls, formatted as per
+// FormatQuarter, e.g. "2020-Q1".
+func (d Date) QuarterString() string {
+	return FormatQuarter(d.Year(), d.Quarter())
+}
+
+// FormatQuarter formats a year and quarter as is common in finance, e.g. "2020-Q1".
+// The year has at least four digits, as per ISO 8601. The result can be parsed using
+// ParseQuarter.
+func FormatQuarter(year, quarter int) string {
+	if year < 0 {
+		return fmt.Sprintf("%05d-Q%d", year, quarter)
+	}
+	return fmt.Sprintf("%04d-Q%d", year, quarter)
+}
+
+// ParseQuarter parses a year and quarter, such as "2020-Q1". The year may come first or
+// last, so "Q1-2020" is also accepted, and the separator may be '-' or a space, as in
+// "Q3 2021". The 'Q' is not case-sensitive. The year must have at least four digits
+// and the quarter must be from 1 to 4. Surrounding whitespace is ignored.
+func ParseQuarter(s string) (year, quarter int, err error) {
+	v := strings.TrimSpace(s)
+
+	i := strings.IndexAny(v, "Qq")
+	var yyyy, q string
+	var sep byte
+	switch {
+	case i == 0 && len(v) > 3:
+		q, sep, yyyy = v[1:2], v[2], v[3:]
+	case i > 1 && i == len(v)-2:
+		yyyy, sep, q = v[:i-1], v[i-1], v[i+1:]
+	default:
+		return 0, 0, fmt.Errorf("date.ParseQuarter: cannot parse %q: incorrect syntax", s)
+	}
+	if sep != '-' && sep != ' ' {
+		return 0, 0, fmt.Errorf("date.ParseQuarter: cannot parse %q: incorrect syntax", s)
+	}
+
+	year, e1 := parseField(yyyy, "year", 4, -1)
+	quarter, e2 := parseField(q, "quarter", -1, 1)
+	if e2 == nil && (quarter < 1 || quarter > 4) {
+		e2 = &ParseError{Field: "quarter", Value: q, Err: errOutOfRange}
+	}
+	if err = errors.Join(e1, e2); err != nil {
+		return 0, 0, fmt.Errorf("date.ParseQuarter: cannot parse %q: %w", s, err)
+	}
+	return year, quarter, nil
+}
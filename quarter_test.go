@@ -0,0 +1,92 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseQuarter(t *testing.T) {
+	cases := []struct {
+		value         string
+		year, quarter int
+	}{
+		{value: "2020-Q1", year: 2020, quarter: 1},
+		{value: "Q1-2020", year: 2020, quarter: 1},
+		{value: "Q3 2021", year: 2021, quarter: 3},
+		{value: "2021 Q3", year: 2021, quarter: 3},
+		{value: "2021-q4", year: 2021, quarter: 4},
+		{value: " q2-1999\n", year: 1999, quarter: 2},
+		{value: "12345-Q2", year: 12345, quarter: 2},
+		{value: "0000-Q1", year: 0, quarter: 1},
+	}
+	for i, c := range cases {
+		year, quarter, err := ParseQuarter(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseQuarter(%q) error %v", i, c.value, err)
+		} else if year != c.year || quarter != c.quarter {
+			t.Errorf("%d: ParseQuarter(%q) == %d, %d; want %d, %d", i, c.value, year, quarter, c.year, c.quarter)
+		}
+	}
+}
+
+func TestParseQuarter_errors(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{value: "2020-Q0", want: `date.ParseQuarter: cannot parse "2020-Q0": quarter out of range`},
+		{value: "Q5-2020", want: `date.ParseQuarter: cannot parse "Q5-2020": quarter out of range`},
+		{value: "2020-Q", want: `date.ParseQuarter: cannot parse "2020-Q": incorrect syntax`},
+		{value: "2020-Q12", want: `date.ParseQuarter: cannot parse "2020-Q12": incorrect syntax`},
+		{value: "2020/Q1", want: `date.ParseQuarter: cannot parse "2020/Q1": incorrect syntax`},
+		{value: "Q1", want: `date.ParseQuarter: cannot parse "Q1": incorrect syntax`},
+		{value: "", want: `date.ParseQuarter: cannot parse "": incorrect syntax`},
+		{value: "20-Q1", want: `date.ParseQuarter: cannot parse "20-Q1": year has wrong length`},
+		{value: "Qx-20y0", want: `date.ParseQuarter: cannot parse "Qx-20y0": ` + "invalid year\ninvalid quarter"},
+	}
+	for i, c := range cases {
+		_, _, err := ParseQuarter(c.value)
+		if err == nil {
+			t.Errorf("%d: ParseQuarter(%q) expected an error", i, c.value)
+		} else if err.Error() != c.want {
+			t.Errorf("%d: ParseQuarter(%q) error %q, want %q", i, c.value, err.Error(), c.want)
+		}
+	}
+}
+
+func TestFormatQuarter_and_QuarterString(t *testing.T) {
+	cases := []struct {
+		d       Date
+		quarter int
+		want    string
+	}{
+		{d: New(2020, time.January, 1), quarter: 1, want: "2020-Q1"},
+		{d: New(2020, time.March, 31), quarter: 1, want: "2020-Q1"},
+		{d: New(2020, time.April, 1), quarter: 2, want: "2020-Q2"},
+		{d: New(2021, time.September, 30), quarter: 3, want: "2021-Q3"},
+		{d: New(2021, time.October, 1), quarter: 4, want: "2021-Q4"},
+		{d: New(999, time.December, 31), quarter: 4, want: "0999-Q4"},
+		{d: New(-1, time.July, 1), quarter: 3, want: "-0001-Q3"},
+	}
+	for i, c := range cases {
+		if q := c.d.Quarter(); q != c.quarter {
+			t.Errorf("%d: %v.Quarter() == %d, want %d", i, c.d, q, c.quarter)
+		}
+		if s := c.d.QuarterString(); s != c.want {
+			t.Errorf("%d: %v.QuarterString() == %q, want %q", i, c.d, s, c.want)
+		}
+		if s := FormatQuarter(c.d.Year(), c.quarter); s != c.want {
+			t.Errorf("%d: FormatQuarter() == %q, want %q", i, s, c.want)
+		}
+		if c.d.Year() >= 0 {
+			year, quarter, err := ParseQuarter(c.want)
+			if err != nil || year != c.d.Year() || quarter != c.quarter {
+				t.Errorf("%d: ParseQuarter(%q) == %d, %d, %v", i, c.want, year, quarter, err)
+			}
+		}
+	}
+}
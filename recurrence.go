@@ -0,0 +1,75 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rickb777/date/v2/gregorian"
+)
+
+// These are the recurrence frequencies supported by ExpandRecurrence. They have the same
+// names as the RFC5545 FREQ rule part.
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+// ExpandRecurrence generates a list of count dates, beginning with start, that recur with
+// the given frequency (Daily, Weekly, Monthly or Yearly) and interval. For example, Weekly
+// with interval 2 gives every other week. This implements a simple subset of the RFC5545
+// RRULE, equivalent to "FREQ=freq;INTERVAL=interval;COUNT=count".
+//
+// For Monthly and Yearly, each occurrence falls on the same day of the month as start. As
+// per RFC5545, occurrences that would fall on a day that does not exist (such as the 31st
+// in a 30-day month, or 29th February in a common year) are skipped rather than clamped;
+// they do not count towards count.
+//
+// The interval must be at least one and the count must not be negative.
+func ExpandRecurrence(start Date, freq string, interval, count int) ([]Date, error) {
+	if interval < 1 {
+		return nil, fmt.Errorf("date.ExpandRecurrence: interval %d must be at least 1", interval)
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("date.ExpandRecurrence: count %d must not be negative", count)
+	}
+
+	switch freq {
+	case Daily:
+		return expandByDays(start, interval, count), nil
+	case Weekly:
+		return expandByDays(start, 7*interval, count), nil
+	case Monthly:
+		return expandByMonths(start, interval, count), nil
+	case Yearly:
+		return expandByMonths(start, 12*interval, count), nil
+	}
+	return nil, fmt.Errorf("date.ExpandRecurrence: unsupported frequency %q", freq)
+}
+
+func expandByDays(start Date, days, count int) []Date {
+	result := make([]Date, count)
+	for i := range result {
+		result[i] = start + Date(i*days)
+	}
+	return result
+}
+
+func expandByMonths(start Date, months, count int) []Date {
+	result := make([]Date, 0, count)
+	year, month, day := start.Date()
+	m0 := year*12 + int(month) - 1
+	for k := 0; len(result) < count; k++ {
+		mk := m0 + k*months
+		y, m := floorDiv(mk, 12), time.Month(mod(mk, 12)+1)
+		if day <= gregorian.DaysIn(y, m) {
+			result = append(result, New(y, m, day))
+		}
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpandRecurrence(t *testing.T) {
+	cases := []struct {
+		start    string
+		freq     string
+		interval int
+		want     string
+	}{
+		{start: "2024-01-30", freq: Daily, interval: 2, want: "[2024-01-30 2024-02-01 2024-02-03 2024-02-05 2024-02-07]"},
+		{start: "2024-01-30", freq: Weekly, interval: 2, want: "[2024-01-30 2024-02-13 2024-02-27 2024-03-12 2024-03-26]"},
+		{start: "2024-01-15", freq: Monthly, interval: 2, want: "[2024-01-15 2024-03-15 2024-05-15 2024-07-15 2024-09-15]"},
+		{start: "2024-08-31", freq: Monthly, interval: 2, want: "[2024-08-31 2024-10-31 2024-12-31 2025-08-31 2025-10-31]"},
+		{start: "-0001-11-30", freq: Monthly, interval: 1, want: "[-0001-11-30 -0001-12-30 0000-01-30 0000-03-30 0000-04-30]"},
+		{start: "2024-06-01", freq: Yearly, interval: 2, want: "[2024-06-01 2026-06-01 2028-06-01 2030-06-01 2032-06-01]"},
+		{start: "2096-02-29", freq: Yearly, interval: 2, want: "[2096-02-29 2104-02-29 2108-02-29 2112-02-29 2116-02-29]"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s %s", i, c.start, c.freq), func(t *testing.T) {
+			list, err := ExpandRecurrence(MustParseISO(c.start), c.freq, c.interval, 5)
+			if err != nil {
+				t.Fatalf("%d: unexpected error %v", i, err)
+			}
+			if fmt.Sprint(list) != c.want {
+				t.Errorf("%d: got %v, want %v", i, list, c.want)
+			}
+		})
+	}
+}
+
+func TestExpandRecurrence_errors(t *testing.T) {
+	start := MustParseISO("2024-01-01")
+	cases := []struct {
+		freq            string
+		interval, count int
+	}{
+		{freq: "HOURLY", interval: 1, count: 1},
+		{freq: "daily", interval: 1, count: 1},
+		{freq: Daily, interval: 0, count: 1},
+		{freq: Daily, interval: 1, count: -1},
+	}
+	for i, c := range cases {
+		_, err := ExpandRecurrence(start, c.freq, c.interval, c.count)
+		if err == nil {
+			t.Errorf("%d: expected error for %s %d %d", i, c.freq, c.interval, c.count)
+		}
+	}
+
+	list, err := ExpandRecurrence(start, Daily, 1, 0)
+	if err != nil || len(list) != 0 {
+		t.Errorf("got %v %v, want empty list", list, err)
+	}
+}
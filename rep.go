@@ -11,28 +11,98 @@ const (
 )
 
 // encode returns the number of days elapsed from date zero to the date
-// corresponding to the given Time value.
+// corresponding to the given Time value. The date is the one in the location
+// specified by t, which is not necessarily UTC.
 func encode(t time.Time) Date {
-	// Compute the number of seconds elapsed since January 1, 1970 00:00:00
-	// in the location specified by t and not necessarily UTC.
-	// A Time value is represented internally as an offset from a UTC base
-	// time; because we want to extract a date in the time zone specified
-	// by t rather than in UTC, we need to compensate for the time zone
-	// difference.
-	_, offset := t.Zone()
-	secs := t.Unix() + int64(offset)
-	if secs >= 0 {
-		return ZeroOffset + Date(secs/secondsPerDay)
-	}
-
-	// Unfortunately operator / rounds towards 0, so negative values
-	// must be handled differently
-	return ZeroOffset - Date((secondsPerDay-1-secs)/secondsPerDay)
+	year, month, day := t.Date()
+	return Date(daysFromCivil(year, month, day))
 }
 
 // decode returns the Time value corresponding to 00:00:00 UTC of the date
 // represented by d, the number of days elapsed since date zero.
 func decode(d Date) time.Time {
-	secs := int64(d-ZeroOffset) * secondsPerDay
-	return time.Unix(secs, 0).UTC()
+	year, month, day := civilFromDays(int(d))
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// marchOffset is the number of days from 0000-03-01, the start of the era used by
+// daysFromCivil and civilFromDays, to date zero.
+const marchOffset = 306
+
+// daysFromCivil returns the number of days from date zero (0001-01-01) to the given date
+// in the proleptic Gregorian calendar, i.e. the Date value for it. As with time.Date, the
+// month and day may be outside their usual ranges and are normalized.
+//
+// This is Howard Hinnant's days_from_civil algorithm, which works in 400-year eras
+// of 146097 days. The internal arithmetic uses int64 so it does not overflow on 32-bit
+// platforms for any year within the supported range.
+//
+// See http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+func daysFromCivil(y int, m time.Month, d int) int {
+	mm := int64(m) - 1
+	yy := int64(y) + floorDiv64(mm, 12)
+	mm -= 12 * floorDiv64(mm, 12)
+
+	// shift the year to start in March, so that any leap day is at the end
+	if mm < 2 {
+		yy--
+		mm += 10
+	} else {
+		mm -= 2
+	}
+
+	era := floorDiv64(yy, 400)
+	yoe := yy - era*400                    // [0, 399]
+	doy := (153*mm+2)/5 + int64(d) - 1     // [0, 365] for valid days
+	doe := yoe*365 + yoe/4 - yoe/100 + doy // [0, 146096] for valid days
+	return int(era*146097 + doe - marchOffset)
+}
+
+// civilFromDays is the inverse of daysFromCivil. It returns the year, month and day of
+// the date that is n days after date zero (0001-01-01).
+//
+// See http://howardhinnant.github.io/date_algorithms.html#civil_from_days
+func civilFromDays(n int) (year int, month time.Month, day int) {
+	z := int64(n) + marchOffset
+	era := floorDiv64(z, 146097)
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], starting in March
+	day = int(doy - (153*mp+2)/5 + 1)
+	y := yoe + era*400
+	if mp < 10 {
+		month = time.Month(mp + 3)
+	} else {
+		month = time.Month(mp - 9)
+		y++
+	}
+	return int(y), month, day
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorDiv64 is as per floorDiv, for int64 values.
+func floorDiv64(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// mod gives the Euclidean remainder of a divided by b, which is never negative for b > 0.
+func mod(a, b int) int {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
 }
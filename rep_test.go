@@ -125,3 +125,63 @@ func TestZone(t *testing.T) {
 		}
 	}
 }
+
+func TestDaysFromCivil_agrees_with_time(t *testing.T) {
+	check := func(d Date) {
+		tm := time.Unix(int64(d-ZeroOffset)*secondsPerDay, 0).UTC()
+		year, month, day := civilFromDays(int(d))
+		if year != tm.Year() || month != tm.Month() || day != tm.Day() {
+			t.Fatalf("civilFromDays(%d) == %d-%d-%d, want %v", d, year, month, day, tm)
+		}
+		if n := daysFromCivil(year, month, day); n != int(d) {
+			t.Fatalf("daysFromCivil(%d, %d, %d) == %d, want %d", year, month, day, n, d)
+		}
+	}
+
+	// every day from 2001 BC to AD 3000
+	for d := New(-2000, time.January, 1); d <= New(3000, time.December, 31); d++ {
+		check(d)
+	}
+
+	// a sample over the whole supported range, including its ends
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100000; i++ {
+		check(MinDate + Date(rng.Int63n(int64(MaxDate-MinDate)+1)))
+	}
+	for i := Date(0); i < 1000; i++ {
+		check(MinDate + i)
+		check(MaxDate - i)
+	}
+}
+
+func TestDaysFromCivil_normalizes(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		day   int
+	}{
+		{2020, 0, 1},
+		{2020, 13, 1},
+		{2020, -25, 31},
+		{2020, 38, -400},
+		{2021, time.February, 29},
+		{2020, time.January, 0},
+		{2020, time.December, 32},
+		{-5, time.March, -1000},
+		{1, time.January, 1000000},
+	}
+	for _, c := range cases {
+		secs := time.Date(c.year, c.month, c.day, 0, 0, 0, 0, time.UTC).Unix()
+		want := ZeroOffset + floorDiv64(secs, secondsPerDay)
+		if n := daysFromCivil(c.year, c.month, c.day); n != int(want) {
+			t.Errorf("daysFromCivil(%d, %d, %d) == %d, want %d", c.year, c.month, c.day, n, want)
+		}
+	}
+}
+
+func BenchmarkDate_Date(b *testing.B) {
+	d := New(2000, time.January, 1)
+	for n := 0; n < b.N; n++ {
+		d.Date()
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+// Hemisphere specifies the northern or southern hemisphere, for which the seasons are opposite.
+type Hemisphere int
+
+// These are the hemispheres accepted by Date.Season. Any other Hemisphere value is
+// treated as Northern.
+const (
+	Northern Hemisphere = iota // north of the equator, e.g. Europe, North America
+	Southern                   // south of the equator, e.g. Australia, South America
+)
+
+// Season is one of the four seasons of the year.
+type Season int
+
+// These are the seasons returned by Date.Season, in order through the year.
+const (
+	Spring Season = iota // March to May in the northern hemisphere
+	Summer               // June to August in the northern hemisphere
+	Autumn               // September to November in the northern hemisphere
+	Winter               // December to February in the northern hemisphere
+)
+
+var seasonNames = []string{"Spring", "Summer", "Autumn", "Winter"}
+
+// String returns the English name of the season.
+func (s Season) String() string {
+	if Spring <= s && s <= Winter {
+		return seasonNames[s]
+	}
+	return "unknown"
+}
+
+// Season returns the meteorological season in which d occurs. In the northern hemisphere,
+// spring starts on 1st March, summer on 1st June, autumn on 1st September and winter on
+// 1st December. In the southern hemisphere, the seasons are the opposite way round, so
+// spring starts on 1st September, and so on. Any hemisphere other than Southern is
+// treated as Northern.
+func (d Date) Season(hemisphere Hemisphere) Season {
+	// months since the start of northern spring (March)
+	m := mod(int(d.Month())-3, 12)
+	s := Season(m / 3)
+	if hemisphere == Southern {
+		s = (s + 2) % 4
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDate_Season(t *testing.T) {
+	cases := []struct {
+		d                  Date
+		northern, southern Season
+	}{
+		{d: New(2024, time.February, 29), northern: Winter, southern: Summer},
+		{d: New(2024, time.March, 1), northern: Spring, southern: Autumn},
+		{d: New(2024, time.May, 31), northern: Spring, southern: Autumn},
+		{d: New(2024, time.June, 1), northern: Summer, southern: Winter},
+		{d: New(2024, time.August, 31), northern: Summer, southern: Winter},
+		{d: New(2024, time.September, 1), northern: Autumn, southern: Spring},
+		{d: New(2024, time.November, 30), northern: Autumn, southern: Spring},
+		{d: New(2024, time.December, 1), northern: Winter, southern: Summer},
+		{d: New(2025, time.January, 1), northern: Winter, southern: Summer},
+		{d: New(-1, time.July, 4), northern: Summer, southern: Winter},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s", i, c.d), func(t *testing.T) {
+			n := c.d.Season(Northern)
+			if n != c.northern {
+				t.Errorf("%d: %v.Season(Northern) == %v, want %v", i, c.d, n, c.northern)
+			}
+			s := c.d.Season(Southern)
+			if s != c.southern {
+				t.Errorf("%d: %v.Season(Southern) == %v, want %v", i, c.d, s, c.southern)
+			}
+		})
+	}
+}
+
+func TestDate_Season_symmetry(t *testing.T) {
+	for d := New(2023, time.January, 1); d < New(2025, time.January, 1); d++ {
+		// six months later, the northern season is the same as the southern season now
+		later := d.AddMonths(6)
+		if later.Season(Northern) != d.Season(Southern) || later.Season(Southern) != d.Season(Northern) {
+			t.Fatalf("%v %v/%v but %v %v/%v", d, d.Season(Northern), d.Season(Southern),
+				later, later.Season(Northern), later.Season(Southern))
+		}
+	}
+}
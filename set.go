@@ -0,0 +1,82 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "slices"
+
+// Set is a set of dates. The zero value is an empty set that is not ready for use;
+// create sets with NewSet (or make).
+type Set map[Date]struct{}
+
+// NewSet returns a new set containing the specified dates.
+func NewSet(dates ...Date) Set {
+	s := make(Set, len(dates))
+	for _, d := range dates {
+		s[d] = struct{}{}
+	}
+	return s
+}
+
+// Add adds dates to the set. Dates already in the set are not altered.
+func (s Set) Add(dates ...Date) {
+	for _, d := range dates {
+		s[d] = struct{}{}
+	}
+}
+
+// Remove removes dates from the set. Dates not in the set are ignored.
+func (s Set) Remove(dates ...Date) {
+	for _, d := range dates {
+		delete(s, d)
+	}
+}
+
+// Contains tests whether a date is in the set.
+func (s Set) Contains(d Date) bool {
+	_, ok := s[d]
+	return ok
+}
+
+// Len returns the number of dates in the set.
+func (s Set) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing all the dates that are in either set.
+func (s Set) Union(other Set) Set {
+	result := make(Set, len(s)+len(other))
+	for d := range s {
+		result[d] = struct{}{}
+	}
+	for d := range other {
+		result[d] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new set containing only the dates that are in both sets.
+func (s Set) Intersect(other Set) Set {
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	result := make(Set)
+	for d := range small {
+		if large.Contains(d) {
+			result[d] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Sorted returns the dates in the set in ascending order.
+func (s Set) Sorted() []Date {
+	list := make([]Date, 0, len(s))
+	for d := range s {
+		list = append(list, d)
+	}
+	slices.Sort(list)
+	return list
+}
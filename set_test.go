@@ -0,0 +1,60 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet(New(2020, time.March, 1), New(-1, time.June, 1))
+	s.Add(New(2020, time.January, 1), New(2020, time.March, 1))
+	s.Add(New(-100, time.December, 31))
+
+	if s.Len() != 4 {
+		t.Errorf("Len() == %d, want 4", s.Len())
+	}
+	if !s.Contains(New(-1, time.June, 1)) || s.Contains(New(-1, time.June, 2)) {
+		t.Errorf("Contains is wrong for %v", s.Sorted())
+	}
+	if fmt.Sprint(s.Sorted()) != "[-0100-12-31 -0001-06-01 2020-01-01 2020-03-01]" {
+		t.Errorf("Sorted() == %v", s.Sorted())
+	}
+
+	s.Remove(New(2020, time.January, 1), New(1999, time.January, 1))
+	if fmt.Sprint(s.Sorted()) != "[-0100-12-31 -0001-06-01 2020-03-01]" {
+		t.Errorf("Sorted() == %v", s.Sorted())
+	}
+
+	if len(NewSet().Sorted()) != 0 {
+		t.Errorf("empty set is not empty")
+	}
+}
+
+func TestSet_Union_and_Intersect(t *testing.T) {
+	s1 := NewSet(New(2020, time.March, 1), New(2020, time.March, 2), New(-5, time.March, 3))
+	s2 := NewSet(New(2020, time.March, 2), New(-5, time.March, 3), New(2020, time.March, 4), New(-5, time.March, 5))
+
+	u := s1.Union(s2)
+	if fmt.Sprint(u.Sorted()) != "[-0005-03-03 -0005-03-05 2020-03-01 2020-03-02 2020-03-04]" {
+		t.Errorf("Union == %v", u.Sorted())
+	}
+
+	i1 := s1.Intersect(s2)
+	i2 := s2.Intersect(s1)
+	if fmt.Sprint(i1.Sorted()) != "[-0005-03-03 2020-03-02]" || fmt.Sprint(i2.Sorted()) != fmt.Sprint(i1.Sorted()) {
+		t.Errorf("Intersect == %v and %v", i1.Sorted(), i2.Sorted())
+	}
+
+	if s1.Len() != 3 || s2.Len() != 4 {
+		t.Errorf("inputs were altered: %v %v", s1.Sorted(), s2.Sorted())
+	}
+
+	if s1.Intersect(NewSet()).Len() != 0 || s1.Union(NewSet()).Len() != 3 {
+		t.Errorf("empty set algebra is wrong")
+	}
+}
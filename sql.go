@@ -16,8 +16,13 @@ import (
 // year 0), or a DATE.
 
 // Scan parses some value. If the value holds a string, the AutoParse function is used.
-// Otherwise, if the value holds an integer, it is treated as the period of days
-// since year 0 value that represents a Date.
+// Otherwise, if the value holds an integer (int64, int or int32), it is treated as the
+// period of days since year 0 value that represents a Date, i.e. the same number as
+// DaysSinceEpoch and ValueAsInt use. This suits INT columns holding day counts.
+//
+// Beware that an integer column might instead hold something else, such as Unix
+// seconds; Scan has no way to tell the difference, so such columns must be converted
+// by other means.
 //
 // This implements sql.Scanner https://golang.org/pkg/database/sql/#Scanner
 func (d *Date) Scan(value interface{}) (err error) {
@@ -33,6 +38,10 @@ func (d *Date) scanAny(value interface{}) (err error) {
 	switch v := value.(type) {
 	case int64:
 		*d = Date(v)
+	case int:
+		*d = Date(v)
+	case int32:
+		*d = Date(v)
 	case []byte:
 		return d.scanString(string(v))
 	case string:
@@ -60,6 +60,32 @@ func TestDate_Scan(t *testing.T) {
 	}
 }
 
+func TestDate_Scan_integer_round_trip(t *testing.T) {
+	cases := []Date{
+		New(2024, 2, 29),
+		New(1970, 1, 1),
+		New(-500, 7, 4),
+		Zero,
+	}
+
+	for i, c := range cases {
+		v, err := ValueAsInt(c)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+
+		for _, src := range []interface{}{v, int(c.DaysSinceEpoch()), int32(c.DaysSinceEpoch())} {
+			r := new(Date)
+			if err = r.Scan(src); err != nil {
+				t.Errorf("%d: Scan(%T %v) gave %v", i, src, src, err)
+			}
+			if *r != c {
+				t.Errorf("%d: Scan(%T %v) == %v, want %v", i, src, src, *r, c)
+			}
+		}
+	}
+}
+
 func TestDate_Scan_with_junk(t *testing.T) {
 	cases := []struct {
 		v        interface{}
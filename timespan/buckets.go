@@ -0,0 +1,62 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package timespan
+
+import (
+	"iter"
+	"time"
+
+	"github.com/rickb777/date/v2"
+)
+
+// Months returns an iterator over the months that overlap the range, in order,
+// including the partial months at either end. An empty range has no months.
+func (dateRange DateRange) Months() iter.Seq[date.YearMonth] {
+	return func(yield func(date.YearMonth) bool) {
+		if dateRange.days <= 0 {
+			return
+		}
+		for ym := dateRange.start.YearMonth(); ym <= dateRange.Last().YearMonth(); ym++ {
+			if !yield(ym) {
+				return
+			}
+		}
+	}
+}
+
+// Quarters returns an iterator over the calendar quarters (starting in January, April,
+// July and October) that overlap the range, in order. The first and last quarters are
+// clipped to the range, so every date in the range is in exactly one of the quarters.
+// An empty range has no quarters.
+func (dateRange DateRange) Quarters() iter.Seq[DateRange] {
+	return dateRange.buckets(func(d date.Date) date.Date {
+		year, month, _ := d.Date()
+		return date.New(year, month-(month-1)%3+3, 1)
+	})
+}
+
+// Weeks returns an iterator over the weeks that overlap the range, in order, with each
+// week starting on firstDay. The first and last weeks are clipped to the range, so every
+// date in the range is in exactly one of the weeks. An empty range has no weeks.
+func (dateRange DateRange) Weeks(firstDay time.Weekday) iter.Seq[DateRange] {
+	return dateRange.buckets(func(d date.Date) date.Date {
+		return d + date.Date(7-d.DaysSince(firstDay))
+	})
+}
+
+// buckets splits the range into consecutive sub-ranges, using next to find the start of
+// the bucket after the one containing a given date.
+func (dateRange DateRange) buckets(next func(date.Date) date.Date) iter.Seq[DateRange] {
+	return func(yield func(DateRange) bool) {
+		end := dateRange.End()
+		for d := dateRange.start; d < end; {
+			n := min(next(d), end)
+			if !yield(BetweenDates(d, n)) {
+				return
+			}
+			d = n
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package timespan
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	. "github.com/rickb777/date/v2"
+)
+
+func TestDateRange_Months(t *testing.T) {
+	cases := []struct {
+		dr   DateRange
+		want string
+	}{
+		{dr: BetweenDates(New(2023, time.November, 15), New(2024, time.February, 10)), want: "[2023-11 2023-12 2024-01 2024-02]"},
+		{dr: BetweenDates(New(2023, time.November, 15), New(2023, time.December, 1)), want: "[2023-11]"},
+		{dr: BetweenDates(New(2023, time.November, 30), New(2023, time.December, 2)), want: "[2023-11 2023-12]"},
+		{dr: NewYearOf(2024), want: "[2024-01 2024-02 2024-03 2024-04 2024-05 2024-06 2024-07 2024-08 2024-09 2024-10 2024-11 2024-12]"},
+		{dr: EmptyRange(d0320), want: "[]"},
+	}
+	for i, c := range cases {
+		isEq(t, i, fmt.Sprint(slices.Collect(c.dr.Months())), c.want, c.dr)
+	}
+}
+
+func TestDateRange_Quarters(t *testing.T) {
+	cases := []struct {
+		dr   DateRange
+		want string
+	}{
+		{dr: BetweenDates(New(2023, time.November, 15), New(2024, time.August, 10)),
+			want: "[47 days from 2023-11-15 to 2023-12-31 91 days from 2024-01-01 to 2024-03-31 91 days from 2024-04-01 to 2024-06-30 40 days from 2024-07-01 to 2024-08-09]"},
+		{dr: NewYearOf(2023),
+			want: "[90 days from 2023-01-01 to 2023-03-31 91 days from 2023-04-01 to 2023-06-30 92 days from 2023-07-01 to 2023-09-30 92 days from 2023-10-01 to 2023-12-31]"},
+		{dr: OneDayRange(New(2024, time.September, 30)), want: "[1 day on 2024-09-30]"},
+		{dr: EmptyRange(d0320), want: "[]"},
+	}
+	for i, c := range cases {
+		isEq(t, i, fmt.Sprint(slices.Collect(c.dr.Quarters())), c.want, c.dr)
+	}
+}
+
+func TestDateRange_Weeks(t *testing.T) {
+	// 2023-12-28 is a Thursday
+	dr := BetweenDates(New(2023, time.December, 28), New(2024, time.January, 12))
+
+	isEq(t, 0, fmt.Sprint(slices.Collect(dr.Weeks(time.Monday))),
+		"[4 days from 2023-12-28 to 2023-12-31 7 days from 2024-01-01 to 2024-01-07 4 days from 2024-01-08 to 2024-01-11]")
+	isEq(t, 1, fmt.Sprint(slices.Collect(dr.Weeks(time.Sunday))),
+		"[3 days from 2023-12-28 to 2023-12-30 7 days from 2023-12-31 to 2024-01-06 5 days from 2024-01-07 to 2024-01-11]")
+	isEq(t, 2, fmt.Sprint(slices.Collect(dr.Weeks(time.Thursday))),
+		"[7 days from 2023-12-28 to 2024-01-03 7 days from 2024-01-04 to 2024-01-10 1 day on 2024-01-11]")
+	isEq(t, 3, len(slices.Collect(EmptyRange(d0320).Weeks(time.Monday))), 0)
+
+	total := PeriodOfDays(0)
+	for w := range dr.Weeks(time.Wednesday) {
+		total += w.Days()
+	}
+	isEq(t, 4, total, dr.Days())
+}
@@ -6,6 +6,8 @@ package timespan
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rickb777/date/v2"
@@ -76,6 +78,48 @@ func NewMonthOf(year int, month time.Month) DateRange {
 	return DateRange{start, PeriodOfDays(end - start)}
 }
 
+// ParsePartial parses a partial date specification, such as "2020" or "January 2020", and
+// returns the range of dates that it covers. The accepted forms are
+//
+//   - a year of at least four digits, e.g. "2020", which is the whole year
+//   - a year and month, e.g. "2020-01", which is the whole month
+//   - a month name (or its three-letter abbreviation) followed by a year, e.g. "January 2020"
+//     or "Jan 2020", which is also the whole month
+//
+// Month names are not case-sensitive. Surrounding whitespace is ignored.
+func ParsePartial(s string) (DateRange, error) {
+	value := strings.TrimSpace(s)
+
+	if year, err := parseYear(value); err == nil {
+		return NewYearOf(year), nil
+	}
+
+	if dash := strings.LastIndexByte(value, '-'); dash > 0 && len(value)-dash == 3 {
+		year, e1 := parseYear(value[:dash])
+		month, e2 := strconv.Atoi(value[dash+1:])
+		if e1 == nil && e2 == nil && 1 <= month && month <= 12 {
+			return NewMonthOf(year, time.Month(month)), nil
+		}
+	}
+
+	for _, layout := range []string{"January 2006", "Jan 2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return NewMonthOf(t.Year(), t.Month()), nil
+		}
+	}
+
+	return DateRange{}, fmt.Errorf("timespan.ParsePartial: cannot parse %q", s)
+}
+
+// parseYear parses a year of at least four digits, with an optional sign.
+func parseYear(s string) (int, error) {
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 || len(digits) < 4 || strings.Trim(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("%q is not a year", s)
+	}
+	return strconv.Atoi(s)
+}
+
 // EmptyRange constructs an empty range. This is often a useful basis for
 // further operations but note that the end date is undefined.
 func EmptyRange(day date.Date) DateRange {
@@ -211,6 +255,70 @@ func (dateRange DateRange) Contains(d date.Date) bool {
 	return dateRange.start <= d && d <= dateRange.Last()
 }
 
+// Overlaps tests whether two date ranges have at least one date in common. Because
+// date ranges are half-open, adjacent ranges (where the end of one is the start of
+// the other) do not overlap. Empty date ranges (i.e. zero days) never overlap anything.
+func (dateRange DateRange) Overlaps(otherRange DateRange) bool {
+	if dateRange.days == 0 || otherRange.days == 0 {
+		return false
+	}
+	return dateRange.start < otherRange.End() && otherRange.start < dateRange.End()
+}
+
+// Intersect calculates the date range that is common to both date ranges. If they
+// overlap, the result is the intersection and true. Otherwise, the zero DateRange and
+// false are returned. See Overlaps for the treatment of adjacent and empty ranges.
+func (dateRange DateRange) Intersect(otherRange DateRange) (DateRange, bool) {
+	if !dateRange.Overlaps(otherRange) {
+		return DateRange{}, false
+	}
+	maxStart := max(dateRange.start, otherRange.start)
+	minEnd := min(dateRange.End(), otherRange.End())
+	return BetweenDates(maxStart, minEnd), true
+}
+
+// Union combines two date ranges. If they overlap or touch (i.e. the end of one is the start of
+// the other), the result is a single range that encompasses both, and true. Otherwise, the result
+// holds the two ranges in ascending order, and false.
+//
+// Unlike Merge, Union never fills in the gap between disjoint ranges.
+func (dateRange DateRange) Union(otherRange DateRange) ([]DateRange, bool) {
+	if dateRange.start <= otherRange.End() && otherRange.start <= dateRange.End() {
+		minStart := min(dateRange.start, otherRange.start)
+		maxEnd := max(dateRange.End(), otherRange.End())
+		return []DateRange{BetweenDates(minStart, maxEnd)}, true
+	}
+	if otherRange.start < dateRange.start {
+		return []DateRange{otherRange, dateRange}, false
+	}
+	return []DateRange{dateRange, otherRange}, false
+}
+
+// Difference calculates the parts of this date range that are not covered by the other range.
+// The result holds between zero and two ranges, in ascending order:
+//
+//   - none if this range is empty or is entirely covered by the other range,
+//   - one if the other range does not overlap this range (the result is this range), or if the
+//     other range covers the start or the end of this range,
+//   - two if the other range lies strictly inside this range, leaving a fragment on each side.
+func (dateRange DateRange) Difference(otherRange DateRange) []DateRange {
+	if dateRange.days == 0 {
+		return nil
+	}
+	if !dateRange.Overlaps(otherRange) {
+		return []DateRange{dateRange}
+	}
+
+	var result []DateRange
+	if dateRange.start < otherRange.start {
+		result = append(result, BetweenDates(dateRange.start, otherRange.start))
+	}
+	if otherRange.End() < dateRange.End() {
+		result = append(result, BetweenDates(otherRange.End(), dateRange.End()))
+	}
+	return result
+}
+
 // StartUTC assumes that the start date is a UTC date and gets the start time of that date, as UTC.
 // It returns midnight on the first day of the range.
 func (dateRange DateRange) StartUTC() time.Time {
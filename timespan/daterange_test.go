@@ -258,6 +258,99 @@ func TestContains2(t *testing.T) {
 	time.Local = old
 }
 
+func TestOverlapsAndIntersect(t *testing.T) {
+	cases := []struct {
+		dr1, dr2 DateRange
+		overlaps bool
+		want     DateRange
+	}{
+		// adjacent
+		{DayRange(d0320, 7), DayRange(d0327, 2), false, DateRange{}},
+		// overlapping
+		{DayRange(d0320, 7), DayRange(d0325, 4), true, BetweenDates(d0325, d0327)},
+		// nested
+		{DayRange(d0320, 12), DayRange(d0325, 2), true, DayRange(d0325, 2)},
+		// identical
+		{DayRange(d0320, 7), DayRange(d0320, 7), true, DayRange(d0320, 7)},
+		// disjoint
+		{DayRange(d0320, 2), DayRange(d0401, 2), false, DateRange{}},
+		// empty within a non-empty range
+		{EmptyRange(d0325), DayRange(d0320, 12), false, DateRange{}},
+		// both empty at the same date
+		{EmptyRange(d0325), EmptyRange(d0325), false, DateRange{}},
+	}
+
+	for i, c := range cases {
+		isEq(t, i, c.dr1.Overlaps(c.dr2), c.overlaps, c.dr1, c.dr2)
+		isEq(t, i, c.dr2.Overlaps(c.dr1), c.overlaps, c.dr2, c.dr1)
+
+		r1, ok1 := c.dr1.Intersect(c.dr2)
+		isEq(t, i, ok1, c.overlaps, c.dr1, c.dr2)
+		isEq(t, i, r1, c.want, c.dr1, c.dr2)
+
+		r2, ok2 := c.dr2.Intersect(c.dr1)
+		isEq(t, i, ok2, c.overlaps, c.dr2, c.dr1)
+		isEq(t, i, r2, c.want, c.dr2, c.dr1)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	cases := []struct {
+		dr1, dr2 DateRange
+		merged   bool
+		want     []DateRange
+	}{
+		// adjacent
+		{DayRange(d0320, 7), DayRange(d0327, 2), true, []DateRange{BetweenDates(d0320, d0329)}},
+		// overlapping
+		{DayRange(d0320, 7), DayRange(d0325, 4), true, []DateRange{BetweenDates(d0320, d0329)}},
+		// nested
+		{DayRange(d0320, 12), DayRange(d0325, 2), true, []DateRange{DayRange(d0320, 12)}},
+		// disjoint
+		{DayRange(d0320, 2), DayRange(d0401, 2), false, []DateRange{DayRange(d0320, 2), DayRange(d0401, 2)}},
+	}
+
+	for i, c := range cases {
+		u1, ok1 := c.dr1.Union(c.dr2)
+		isEq(t, i, ok1, c.merged, c.dr1, c.dr2)
+		isEq(t, i, fmt.Sprint(u1), fmt.Sprint(c.want), c.dr1, c.dr2)
+
+		u2, ok2 := c.dr2.Union(c.dr1)
+		isEq(t, i, ok2, c.merged, c.dr2, c.dr1)
+		isEq(t, i, fmt.Sprint(u2), fmt.Sprint(c.want), c.dr2, c.dr1)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	cases := []struct {
+		dr1, dr2 DateRange
+		want     []DateRange
+	}{
+		// zero fragments
+		{DayRange(d0325, 2), DayRange(d0320, 12), nil},
+		{DayRange(d0325, 2), DayRange(d0325, 2), nil},
+		{EmptyRange(d0325), DayRange(d0401, 2), nil},
+		// one fragment: disjoint or adjacent
+		{DayRange(d0320, 2), DayRange(d0401, 2), []DateRange{DayRange(d0320, 2)}},
+		{DayRange(d0320, 7), DayRange(d0327, 2), []DateRange{DayRange(d0320, 7)}},
+		{DayRange(d0320, 7), EmptyRange(d0325), []DateRange{DayRange(d0320, 7)}},
+		// one fragment: left
+		{DayRange(d0320, 7), DayRange(d0325, 4), []DateRange{BetweenDates(d0320, d0325)}},
+		{DayRange(d0320, 7), BetweenDates(d0325, d0327), []DateRange{BetweenDates(d0320, d0325)}},
+		// one fragment: right
+		{DayRange(d0325, 4), DayRange(d0320, 7), []DateRange{BetweenDates(d0327, d0329)}},
+		{DayRange(d0320, 7), BetweenDates(d0320, d0325), []DateRange{BetweenDates(d0325, d0327)}},
+		// two fragments
+		{DayRange(d0320, 12), DayRange(d0325, 2), []DateRange{BetweenDates(d0320, d0325), BetweenDates(d0327, d0401)}},
+	}
+
+	for i, c := range cases {
+		diff := c.dr1.Difference(c.dr2)
+		isEq(t, i, len(diff), len(c.want), c.dr1, c.dr2)
+		isEq(t, i, fmt.Sprint(diff), fmt.Sprint(c.want), c.dr1, c.dr2)
+	}
+}
+
 func TestContainsTime0(t *testing.T) {
 	old := time.Local
 	time.Local = time.FixedZone("Test", 7200)
@@ -375,3 +468,39 @@ func isEq(t *testing.T, i int, a, b interface{}, msg ...interface{}) {
 		t.Errorf("%d: %+v is not equal to %+v%s", i, a, b, strings.Join(sa, ""))
 	}
 }
+
+func TestParsePartial(t *testing.T) {
+	cases := []struct {
+		s           string
+		first, last Date
+	}{
+		{s: "2020", first: New(2020, time.January, 1), last: New(2020, time.December, 31)},
+		{s: " 1999 ", first: New(1999, time.January, 1), last: New(1999, time.December, 31)},
+		{s: "-0001", first: New(-1, time.January, 1), last: New(-1, time.December, 31)},
+		{s: "January 2020", first: New(2020, time.January, 1), last: New(2020, time.January, 31)},
+		{s: "february 2024", first: New(2024, time.February, 1), last: New(2024, time.February, 29)},
+		{s: "Feb 2023", first: New(2023, time.February, 1), last: New(2023, time.February, 28)},
+		{s: "2020-01", first: New(2020, time.January, 1), last: New(2020, time.January, 31)},
+		{s: "2023-11", first: New(2023, time.November, 1), last: New(2023, time.November, 30)},
+		{s: "+12345-06", first: New(12345, time.June, 1), last: New(12345, time.June, 30)},
+	}
+	for i, c := range cases {
+		r, err := ParsePartial(c.s)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		isEq(t, i, r.Start(), c.first, c.s)
+		isEq(t, i, r.Last(), c.last, c.s)
+	}
+}
+
+func TestParsePartial_errors(t *testing.T) {
+	cases := []string{"", "20", "2020-13", "2020-1", "2020-01-02", "Janvier 2020", "January", "--2020", "2020x"}
+	for i, c := range cases {
+		r, err := ParsePartial(c)
+		if err == nil {
+			t.Errorf("%d: ParsePartial(%q) == %v", i, c, r)
+		}
+	}
+}
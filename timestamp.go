@@ -0,0 +1,34 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+// TimestampDate is a Date that is marshalled as an RFC 3339 timestamp at midnight UTC
+// (e.g. "2006-01-02T00:00:00Z"), for consumers that expect a full timestamp even for
+// date-only values. Convert to and from Date as needed, e.g. Date(td).
+type TimestampDate Date
+
+// MarshalText implements the encoding.TextMarshaler interface, which is also used for JSON.
+// The result is the date as per Date.MarshalText followed by "T00:00:00Z". Years outside
+// the [0,9999] range have a sign prefix and possibly more digits.
+func (td TimestampDate) MarshalText() ([]byte, error) {
+	return []byte(Date(td).String() + "T00:00:00Z"), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, which is also used for JSON.
+// It accepts either a full timestamp or a bare date, as per ParseISO. The calendar date is
+// taken as written; any time and zone offset are ignored. A blank string gives the zero value.
+func (td *TimestampDate) UnmarshalText(data []byte) error {
+	var d Date
+	if err := d.UnmarshalText(data); err != nil {
+		return err
+	}
+	*td = TimestampDate(d)
+	return nil
+}
+
+// String returns the date in ISO 8601 extended format, as per Date.String.
+func (td TimestampDate) String() string {
+	return Date(td).String()
+}
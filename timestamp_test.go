@@ -0,0 +1,67 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampDate_JSON(t *testing.T) {
+	type record struct {
+		At TimestampDate `json:"at"`
+	}
+	cases := []struct {
+		d    Date
+		json string
+	}{
+		{d: New(2020, time.January, 2), json: `{"at":"2020-01-02T00:00:00Z"}`},
+		{d: New(1, time.January, 1), json: `{"at":"0001-01-01T00:00:00Z"}`},
+		{d: New(-1, time.December, 31), json: `{"at":"-0001-12-31T00:00:00Z"}`},
+		{d: New(12345, time.June, 7), json: `{"at":"+12345-06-07T00:00:00Z"}`},
+	}
+	for i, c := range cases {
+		bb, err := json.Marshal(record{At: TimestampDate(c.d)})
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if string(bb) != c.json {
+			t.Errorf("%d: got %s, want %s", i, bb, c.json)
+		}
+
+		var r record
+		if err = json.Unmarshal(bb, &r); err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if Date(r.At) != c.d {
+			t.Errorf("%d: got %v, want %v", i, r.At, c.d)
+		}
+	}
+}
+
+func TestTimestampDate_UnmarshalJSON_forms(t *testing.T) {
+	want := New(2020, time.January, 2)
+	cases := []string{
+		`"2020-01-02T00:00:00Z"`,
+		`"2020-01-02"`,
+		`"2020-01-02T15:04:05-07:00"`,
+		`"2020-01-02T00:00:00.123Z"`,
+	}
+	for i, c := range cases {
+		var td TimestampDate
+		if err := json.Unmarshal([]byte(c), &td); err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+		if Date(td) != want {
+			t.Errorf("%d: got %v, want %v", i, td, want)
+		}
+	}
+
+	var td TimestampDate
+	if err := json.Unmarshal([]byte(`"2020-01-02T1"`), &td); err == nil {
+		t.Errorf("expected an error")
+	}
+}
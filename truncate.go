@@ -0,0 +1,57 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import "time"
+
+// Unit is a calendar unit used for truncating dates.
+type Unit int
+
+// These are the units supported by Truncate.
+const (
+	UnitDay Unit = iota
+	UnitWeek
+	UnitMonth
+	UnitQuarter
+	UnitYear
+)
+
+var unitNames = []string{"day", "week", "month", "quarter", "year"}
+
+// String returns the name of the unit in lowercase.
+func (u Unit) String() string {
+	if UnitDay <= u && u <= UnitYear {
+		return unitNames[u]
+	}
+	return "unknown"
+}
+
+// Truncate returns the first date of the unit that contains d. For example,
+// truncating to UnitMonth gives the first day of the month; truncating to UnitQuarter
+// gives 1st January, 1st April, 1st July or 1st October. UnitDay leaves d unchanged.
+//
+// UnitWeek truncates to the most recent Monday that is on or before d, as per ISO 8601;
+// use TruncateWeek for weeks that start on a different day.
+func (d Date) Truncate(unit Unit) Date {
+	switch unit {
+	case UnitWeek:
+		return d.TruncateWeek(time.Monday)
+	case UnitMonth:
+		return d - Date(d.Day()-1)
+	case UnitQuarter:
+		year, month, _ := d.Date()
+		return New(year, month-(month-1)%3, 1)
+	case UnitYear:
+		return d - Date(d.YearDay()-1)
+	}
+	return d
+}
+
+// TruncateWeek returns the first date of the week that contains d, for weeks that start
+// on firstDay; i.e. the most recent firstDay that is on or before d. For example,
+// TruncateWeek(time.Sunday) suits locales whose weeks start on Sunday.
+func (d Date) TruncateWeek(firstDay time.Weekday) Date {
+	return d.WeekdayOnOrBefore(firstDay)
+}
@@ -0,0 +1,63 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDate_Truncate(t *testing.T) {
+	cases := []struct {
+		value string
+		unit  Unit
+		want  string
+	}{
+		{value: "2024-08-14", unit: UnitDay, want: "2024-08-14"},
+		{value: "2024-08-14", unit: UnitWeek, want: "2024-08-12"},
+		{value: "2024-08-12", unit: UnitWeek, want: "2024-08-12"},
+		{value: "2024-08-18", unit: UnitWeek, want: "2024-08-12"},
+		{value: "2024-01-03", unit: UnitWeek, want: "2024-01-01"},
+		{value: "2021-01-03", unit: UnitWeek, want: "2020-12-28"},
+		{value: "2024-08-14", unit: UnitMonth, want: "2024-08-01"},
+		{value: "2024-08-01", unit: UnitMonth, want: "2024-08-01"},
+		{value: "2024-02-29", unit: UnitMonth, want: "2024-02-01"},
+		{value: "2024-01-01", unit: UnitQuarter, want: "2024-01-01"},
+		{value: "2024-03-31", unit: UnitQuarter, want: "2024-01-01"},
+		{value: "2024-04-01", unit: UnitQuarter, want: "2024-04-01"},
+		{value: "2024-08-14", unit: UnitQuarter, want: "2024-07-01"},
+		{value: "2024-12-31", unit: UnitQuarter, want: "2024-10-01"},
+		{value: "2024-12-31", unit: UnitYear, want: "2024-01-01"},
+		{value: "-0001-06-15", unit: UnitYear, want: "-0001-01-01"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%d %s %s", i, c.value, c.unit), func(t *testing.T) {
+			d := MustParseISO(c.value).Truncate(c.unit)
+			if d.String() != c.want {
+				t.Errorf("%d: %s.Truncate(%s) == %s, want %s", i, c.value, c.unit, d, c.want)
+			}
+		})
+	}
+}
+
+func TestDate_TruncateWeek(t *testing.T) {
+	cases := []struct {
+		first time.Weekday
+		want  string
+	}{
+		{first: time.Sunday, want: "2024-08-11"},
+		{first: time.Monday, want: "2024-08-12"},
+		{first: time.Wednesday, want: "2024-08-14"},
+		{first: time.Thursday, want: "2024-08-08"},
+		{first: time.Saturday, want: "2024-08-10"},
+	}
+	for i, c := range cases {
+		d := MustParseISO("2024-08-14").TruncateWeek(c.first)
+		if d.String() != c.want {
+			t.Errorf("%d: TruncateWeek(%s) == %s, want %s", i, c.first, d, c.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WeekdaySet is a set of days of the week, held as a bitmask. This is useful for
+// recurrence rules such as the RFC5545 BYDAY rule part. The zero value is the empty set.
+type WeekdaySet uint8
+
+// Weekdays contains Monday to Friday inclusive.
+const Weekdays WeekdaySet = 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday
+
+// Weekend contains Saturday and Sunday.
+const Weekend WeekdaySet = 1<<time.Saturday | 1<<time.Sunday
+
+// NewWeekdaySet returns the set containing the specified days.
+func NewWeekdaySet(days ...time.Weekday) WeekdaySet {
+	var s WeekdaySet
+	for _, wd := range days {
+		s = s.Add(wd)
+	}
+	return s
+}
+
+// Add returns a new set containing the days in s and also the day wd. Values of wd
+// outside the range Sunday to Saturday are taken modulo 7, so -1 is Saturday.
+func (s WeekdaySet) Add(wd time.Weekday) WeekdaySet {
+	return s | 1<<mod(int(wd), 7)
+}
+
+// Contains tests whether the day wd is in the set. As with Add, values of wd outside
+// the range Sunday to Saturday are taken modulo 7.
+func (s WeekdaySet) Contains(wd time.Weekday) bool {
+	return s&(1<<mod(int(wd), 7)) != 0
+}
+
+// Len returns the number of days in the set.
+func (s WeekdaySet) Len() int {
+	n := 0
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if s.Contains(wd) {
+			n++
+		}
+	}
+	return n
+}
+
+// String returns the set as a comma-separated list of the two-letter day codes used by
+// RFC5545, starting on Monday (e.g. "MO,WE,FR"). The empty set is a blank string.
+func (s WeekdaySet) String() string {
+	buf := &strings.Builder{}
+	for i := 0; i < 7; i++ {
+		wd := (time.Monday + time.Weekday(i)) % 7
+		if s.Contains(wd) {
+			if buf.Len() > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(weekdayCodes[wd])
+		}
+	}
+	return buf.String()
+}
+
+// ParseWeekdaySet parses a comma-separated list of RFC5545 two-letter day codes
+// (SU, MO, TU, WE, TH, FR, SA), such as "MO,WE,FR". The codes are not case-sensitive
+// and surrounding whitespace is ignored. A blank string gives the empty set.
+func ParseWeekdaySet(value string) (WeekdaySet, error) {
+	var s WeekdaySet
+	if strings.TrimSpace(value) == "" {
+		return s, nil
+	}
+
+	for _, code := range strings.Split(value, ",") {
+		wd, err := parseWeekdayCode(strings.TrimSpace(code))
+		if err != nil {
+			return 0, fmt.Errorf("date.ParseWeekdaySet: cannot parse %q: %w", value, err)
+		}
+		s = s.Add(wd)
+	}
+	return s, nil
+}
+
+func parseWeekdayCode(code string) (time.Weekday, error) {
+	upper := strings.ToUpper(code)
+	for wd, c := range weekdayCodes {
+		if c == upper {
+			return time.Weekday(wd), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown day code %q", code)
+}
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// InSet tests whether the day of the week of d is in the set s.
+func (d Date) InSet(s WeekdaySet) bool {
+	return s.Contains(d.Weekday())
+}
+
+// DaysUntil returns the number of days forward from d to the next occurrence of the given
+// day of the week, in the range [0,6]. The result is zero if d is already that day.
+func (d Date) DaysUntil(weekday time.Weekday) int {
+	return mod(int(weekday-d.Weekday()), 7)
+}
+
+// DaysSince returns the number of days backward from d to the previous occurrence of the
+// given day of the week, in the range [0,6]. The result is zero if d is already that day.
+func (d Date) DaysSince(weekday time.Weekday) int {
+	return mod(int(d.Weekday()-weekday), 7)
+}
+
+// WeekdayOnOrBefore returns the latest date that is on or before d and falls on the given
+// day of the week; for example, the most recent Friday. The result is d itself if d is
+// already that day.
+func (d Date) WeekdayOnOrBefore(weekday time.Weekday) Date {
+	return d - Date(d.DaysSince(weekday))
+}
+
+// WeekdayOnOrAfter returns the earliest date that is on or after d and falls on the given
+// day of the week; for example, the coming Friday. The result is d itself if d is already
+// that day.
+func (d Date) WeekdayOnOrAfter(weekday time.Weekday) Date {
+	return d + Date(d.DaysUntil(weekday))
+}
+
+// NearestWeekday returns the occurrence of the given day of the week that is closest to d,
+// looking both backward and forward; for example, Sunday rounds d to the nearest Sunday.
+// The result is d itself if d is already that day. Otherwise, the previous and next
+// occurrences are 7 days apart, so one is at most 3 days away and the other at least 4;
+// no tie can occur.
+func (d Date) NearestWeekday(weekday time.Weekday) Date {
+	before, after := d.DaysSince(weekday), d.DaysUntil(weekday)
+	if before < after {
+		return d - Date(before)
+	}
+	return d + Date(after)
+}
+
+// NthWeekdayOfYear returns the nth occurrence of the given day of the week in the given
+// year; for example, n=10 and Sunday gives the 10th Sunday of the year. A negative n counts
+// back from the end of the year, so -1 gives the last such day. If there is no such
+// occurrence (e.g. a 54th Sunday, or n=0), the result is false.
+func NthWeekdayOfYear(year int, weekday time.Weekday, n int) (Date, bool) {
+	return nthWeekdayBetween(New(year, time.January, 1), New(year+1, time.January, 1)-1, weekday, n)
+}
+
+// NthWeekdayOfMonth returns the nth occurrence of the given day of the week in the given
+// month; for example, n=2 and Tuesday gives the second Tuesday of the month. A negative n
+// counts back from the end of the month, so -1 gives the last such day. If there is no such
+// occurrence (e.g. a 6th Tuesday, or n=0), the result is false.
+func NthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (Date, bool) {
+	return nthWeekdayBetween(New(year, month, 1), New(year, month+1, 1)-1, weekday, n)
+}
+
+// FirstWeekdayOfMonth returns the first occurrence of the given day of the week in the
+// given month; for example, Monday gives the first Monday of the month. Unlike
+// NthWeekdayOfMonth, there is always such a day.
+func FirstWeekdayOfMonth(year int, month time.Month, weekday time.Weekday) Date {
+	first := New(year, month, 1)
+	return first + Date(first.DaysUntil(weekday))
+}
+
+// LastWeekdayOfMonth returns the last occurrence of the given day of the week in the
+// given month; for example, Monday gives the last Monday of the month. Unlike
+// NthWeekdayOfMonth, there is always such a day.
+func LastWeekdayOfMonth(year int, month time.Month, weekday time.Weekday) Date {
+	last := New(year, month+1, 1) - 1
+	return last - Date(last.DaysSince(weekday))
+}
+
+func nthWeekdayBetween(first, last Date, weekday time.Weekday, n int) (Date, bool) {
+	var d Date
+	switch {
+	case n > 0:
+		d = first + Date(first.DaysUntil(weekday)+7*(n-1))
+	case n < 0:
+		d = last - Date(last.DaysSince(weekday)+7*(-n-1))
+	default:
+		return 0, false
+	}
+	if d < first || d > last {
+		return 0, false
+	}
+	return d, true
+}
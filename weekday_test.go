@@ -0,0 +1,290 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekdaySet_round_trip(t *testing.T) {
+	cases := []struct {
+		set  WeekdaySet
+		want string
+		n    int
+	}{
+		{set: 0, want: "", n: 0},
+		{set: NewWeekdaySet(time.Monday), want: "MO", n: 1},
+		{set: NewWeekdaySet(time.Friday, time.Monday, time.Wednesday), want: "MO,WE,FR", n: 3},
+		{set: NewWeekdaySet(time.Sunday, time.Saturday), want: "SA,SU", n: 2},
+		{set: Weekdays, want: "MO,TU,WE,TH,FR", n: 5},
+		{set: Weekend, want: "SA,SU", n: 2},
+		{set: Weekdays | Weekend, want: "MO,TU,WE,TH,FR,SA,SU", n: 7},
+	}
+	for i, c := range cases {
+		s := c.set.String()
+		if s != c.want {
+			t.Errorf("%d: String() == %q, want %q", i, s, c.want)
+		}
+		if c.set.Len() != c.n {
+			t.Errorf("%d: Len() == %d, want %d", i, c.set.Len(), c.n)
+		}
+		p, err := ParseWeekdaySet(s)
+		if err != nil {
+			t.Errorf("%d: ParseWeekdaySet(%q) error %v", i, s, err)
+		} else if p != c.set {
+			t.Errorf("%d: ParseWeekdaySet(%q) == %v, want %v", i, s, p, c.set)
+		}
+	}
+}
+
+func TestParseWeekdaySet(t *testing.T) {
+	cases := []struct {
+		value string
+		want  WeekdaySet
+	}{
+		{value: " ", want: 0},
+		{value: "mo, we ,Fr", want: NewWeekdaySet(time.Monday, time.Wednesday, time.Friday)},
+		{value: "SU,SU", want: NewWeekdaySet(time.Sunday)},
+	}
+	for i, c := range cases {
+		s, err := ParseWeekdaySet(c.value)
+		if err != nil {
+			t.Errorf("%d: ParseWeekdaySet(%q) error %v", i, c.value, err)
+		} else if s != c.want {
+			t.Errorf("%d: ParseWeekdaySet(%q) == %v, want %v", i, c.value, s, c.want)
+		}
+	}
+
+	bads := []string{"MO,", "MON", "XX", "MO;WE"}
+	for i, c := range bads {
+		_, err := ParseWeekdaySet(c)
+		if err == nil {
+			t.Errorf("%d: ParseWeekdaySet(%q) expected error", i, c)
+		}
+	}
+}
+
+func TestWeekdaySet_Contains(t *testing.T) {
+	s := NewWeekdaySet(time.Monday, time.Wednesday).Add(time.Friday)
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		want := wd == time.Monday || wd == time.Wednesday || wd == time.Friday
+		if s.Contains(wd) != want {
+			t.Errorf("%v.Contains(%v) == %v, want %v", s, wd, s.Contains(wd), want)
+		}
+	}
+
+	// out-of-range values are taken modulo 7 rather than panicking
+	if r := NewWeekdaySet().Add(time.Weekday(-1)); r != NewWeekdaySet(time.Saturday) {
+		t.Errorf("Add(-1) == %v, want SA", r)
+	}
+	if r := NewWeekdaySet().Add(time.Weekday(8)); r != NewWeekdaySet(time.Monday) {
+		t.Errorf("Add(8) == %v, want MO", r)
+	}
+	if !s.Contains(time.Weekday(-6)) || s.Contains(time.Weekday(-7)) || !s.Contains(time.Weekday(-9)) {
+		t.Errorf("%v.Contains gave wrong results for negative weekdays", s)
+	}
+
+	d := New(2024, time.March, 4) // Monday
+	for i := 0; i < 7; i++ {
+		want := i == 0 || i == 2 || i == 4
+		if (d + Date(i)).InSet(s) != want {
+			t.Errorf("%v.InSet(%v) == %v, want %v", d+Date(i), s, !want, want)
+		}
+	}
+}
+
+func TestDate_DaysUntil_and_DaysSince(t *testing.T) {
+	d := New(2024, time.March, 6) // Wednesday
+	cases := []struct {
+		weekday      time.Weekday
+		until, since int
+	}{
+		{weekday: time.Sunday, until: 4, since: 3},
+		{weekday: time.Monday, until: 5, since: 2},
+		{weekday: time.Tuesday, until: 6, since: 1},
+		{weekday: time.Wednesday, until: 0, since: 0},
+		{weekday: time.Thursday, until: 1, since: 6},
+		{weekday: time.Friday, until: 2, since: 5},
+		{weekday: time.Saturday, until: 3, since: 4},
+	}
+	for i, c := range cases {
+		until := d.DaysUntil(c.weekday)
+		if until != c.until {
+			t.Errorf("%d: %v.DaysUntil(%v) == %d, want %d", i, d, c.weekday, until, c.until)
+		}
+		if (d + Date(until)).Weekday() != c.weekday {
+			t.Errorf("%d: %v + %d is not %v", i, d, until, c.weekday)
+		}
+		since := d.DaysSince(c.weekday)
+		if since != c.since {
+			t.Errorf("%d: %v.DaysSince(%v) == %d, want %d", i, d, c.weekday, since, c.since)
+		}
+		if (d - Date(since)).Weekday() != c.weekday {
+			t.Errorf("%d: %v - %d is not %v", i, d, since, c.weekday)
+		}
+	}
+}
+
+func TestDate_NearestWeekday(t *testing.T) {
+	sunday := New(2024, time.March, 10)
+	cases := []struct {
+		d, expected Date
+	}{
+		{d: sunday, expected: sunday},
+		{d: sunday + 1, expected: sunday},
+		{d: sunday + 3, expected: sunday},
+		// the nearest to equidistant: 4 days after one Sunday and 3 before the next
+		{d: sunday + 4, expected: sunday + 7},
+		{d: sunday + 6, expected: sunday + 7},
+		{d: sunday - 3, expected: sunday},
+		{d: sunday - 4, expected: sunday - 7},
+	}
+	for i, c := range cases {
+		if r := c.d.NearestWeekday(time.Sunday); r != c.expected {
+			t.Errorf("%d: %v.NearestWeekday(Sunday) == %v, want %v", i, c.d, r, c.expected)
+		}
+	}
+
+	for d := sunday - 10; d < sunday+10; d++ {
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			r := d.NearestWeekday(weekday)
+			if r.Weekday() != weekday || r-d < -3 || r-d > 3 {
+				t.Errorf("%v.NearestWeekday(%v) == %v", d, weekday, r)
+			}
+		}
+	}
+}
+
+func TestDate_WeekdayOnOrBefore_and_WeekdayOnOrAfter(t *testing.T) {
+	friday := New(2024, time.March, 8)
+	cases := []struct {
+		d             Date
+		before, after Date
+	}{
+		{d: friday - 7, before: friday - 7, after: friday - 7},
+		{d: friday - 6, before: friday - 7, after: friday},
+		{d: friday - 1, before: friday - 7, after: friday},
+		{d: friday, before: friday, after: friday},
+		{d: friday + 1, before: friday, after: friday + 7},
+		{d: friday + 6, before: friday, after: friday + 7},
+		{d: friday + 7, before: friday + 7, after: friday + 7},
+	}
+	for i, c := range cases {
+		if r := c.d.WeekdayOnOrBefore(time.Friday); r != c.before {
+			t.Errorf("%d: %v.WeekdayOnOrBefore(Friday) == %v, want %v", i, c.d, r, c.before)
+		}
+		if r := c.d.WeekdayOnOrAfter(time.Friday); r != c.after {
+			t.Errorf("%d: %v.WeekdayOnOrAfter(Friday) == %v, want %v", i, c.d, r, c.after)
+		}
+	}
+
+	for d := friday - 10; d < friday+10; d++ {
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			b, a := d.WeekdayOnOrBefore(weekday), d.WeekdayOnOrAfter(weekday)
+			if b.Weekday() != weekday || b > d || d-b > 6 {
+				t.Errorf("%v.WeekdayOnOrBefore(%v) == %v", d, weekday, b)
+			}
+			if a.Weekday() != weekday || a < d || a-d > 6 {
+				t.Errorf("%v.WeekdayOnOrAfter(%v) == %v", d, weekday, a)
+			}
+		}
+	}
+}
+
+func TestNthWeekdayOfYear(t *testing.T) {
+	cases := []struct {
+		year    int
+		weekday time.Weekday
+		n       int
+		want    Date
+		ok      bool
+	}{
+		// 2024 starts on a Monday and ends on a Tuesday
+		{year: 2024, weekday: time.Monday, n: 1, want: New(2024, time.January, 1), ok: true},
+		{year: 2024, weekday: time.Sunday, n: 1, want: New(2024, time.January, 7), ok: true},
+		{year: 2024, weekday: time.Sunday, n: 10, want: New(2024, time.March, 10), ok: true},
+		{year: 2024, weekday: time.Sunday, n: 52, want: New(2024, time.December, 29), ok: true},
+		{year: 2024, weekday: time.Monday, n: 53, want: New(2024, time.December, 30), ok: true},
+		{year: 2024, weekday: time.Sunday, n: 53},
+		{year: 2024, weekday: time.Sunday, n: 54},
+		{year: 2024, weekday: time.Tuesday, n: -1, want: New(2024, time.December, 31), ok: true},
+		{year: 2024, weekday: time.Sunday, n: -1, want: New(2024, time.December, 29), ok: true},
+		{year: 2024, weekday: time.Sunday, n: -52, want: New(2024, time.January, 7), ok: true},
+		{year: 2024, weekday: time.Sunday, n: -53},
+		{year: 2024, weekday: time.Sunday, n: 0},
+		{year: -1, weekday: time.Friday, n: 1, want: New(-1, time.January, 1), ok: true},
+	}
+	for i, c := range cases {
+		d, ok := NthWeekdayOfYear(c.year, c.weekday, c.n)
+		if ok != c.ok || d != c.want {
+			t.Errorf("%d: NthWeekdayOfYear(%d, %v, %d) == %v, %v; want %v, %v", i, c.year, c.weekday, c.n, d, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	cases := []struct {
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    Date
+		ok      bool
+	}{
+		// May 2024 starts on a Wednesday and ends on a Friday
+		{month: time.May, weekday: time.Wednesday, n: 1, want: New(2024, time.May, 1), ok: true},
+		{month: time.May, weekday: time.Monday, n: 2, want: New(2024, time.May, 13), ok: true},
+		{month: time.May, weekday: time.Friday, n: 5, want: New(2024, time.May, 31), ok: true},
+		{month: time.May, weekday: time.Monday, n: 5},
+		{month: time.May, weekday: time.Monday, n: -1, want: New(2024, time.May, 27), ok: true},
+		{month: time.May, weekday: time.Thursday, n: -5, want: New(2024, time.May, 2), ok: true},
+		{month: time.May, weekday: time.Monday, n: -5},
+	}
+	for i, c := range cases {
+		d, ok := NthWeekdayOfMonth(2024, c.month, c.weekday, c.n)
+		if ok != c.ok || d != c.want {
+			t.Errorf("%d: NthWeekdayOfMonth(2024, %v, %v, %d) == %v, %v; want %v, %v", i, c.month, c.weekday, c.n, d, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestFirstWeekdayOfMonth_and_LastWeekdayOfMonth(t *testing.T) {
+	cases := []struct {
+		year        int
+		month       time.Month
+		first, last Date
+	}{
+		// starts on a Monday, ends on a Wednesday
+		{year: 2024, month: time.January, first: New(2024, time.January, 1), last: New(2024, time.January, 29)},
+		// starts on a Thursday, ends on a Thursday
+		{year: 2024, month: time.February, first: New(2024, time.February, 5), last: New(2024, time.February, 26)},
+		// starts on a Sunday, ends on a Monday
+		{year: 2024, month: time.September, first: New(2024, time.September, 2), last: New(2024, time.September, 30)},
+		// starts on a Tuesday, ends on a Thursday
+		{year: 2023, month: time.August, first: New(2023, time.August, 7), last: New(2023, time.August, 28)},
+		// starts on a Monday, ends on a Sunday (non-leap February)
+		{year: 2021, month: time.February, first: New(2021, time.February, 1), last: New(2021, time.February, 22)},
+	}
+	for i, c := range cases {
+		first := FirstWeekdayOfMonth(c.year, c.month, time.Monday)
+		last := LastWeekdayOfMonth(c.year, c.month, time.Monday)
+		if first != c.first || last != c.last {
+			t.Errorf("%d: %d %v got %v, %v; want %v, %v", i, c.year, c.month, first, last, c.first, c.last)
+		}
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			first, _ := NthWeekdayOfMonth(2024, month, weekday, 1)
+			last, _ := NthWeekdayOfMonth(2024, month, weekday, -1)
+			if d := FirstWeekdayOfMonth(2024, month, weekday); d != first {
+				t.Errorf("FirstWeekdayOfMonth(2024, %v, %v) == %v, want %v", month, weekday, d, first)
+			}
+			if d := LastWeekdayOfMonth(2024, month, weekday); d != last {
+				t.Errorf("LastWeekdayOfMonth(2024, %v, %v) == %v, want %v", month, weekday, d, last)
+			}
+		}
+	}
+}
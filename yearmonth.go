@@ -0,0 +1,84 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rickb777/date/v2/gregorian"
+)
+
+// YearMonth is a particular month of a particular year, e.g. January 2006. It is useful
+// as a key for monthly aggregation. It is the number of months since January of year 0,
+// so it can be compared and used as a map key directly.
+type YearMonth int
+
+// NewYearMonth returns the YearMonth for the given year and month. The month may be
+// outside its usual range and will be normalized.
+func NewYearMonth(year int, month time.Month) YearMonth {
+	return YearMonth(year*12 + int(month) - 1)
+}
+
+// YearMonth returns the year and month of d.
+func (d Date) YearMonth() YearMonth {
+	year, month, _ := d.Date()
+	return NewYearMonth(year, month)
+}
+
+// Year returns the year of ym.
+func (ym YearMonth) Year() int {
+	return floorDiv(int(ym), 12)
+}
+
+// Month returns the month of ym.
+func (ym YearMonth) Month() time.Month {
+	return time.Month(mod(int(ym), 12) + 1)
+}
+
+// Next returns the month after ym.
+func (ym YearMonth) Next() YearMonth {
+	return ym + 1
+}
+
+// Prev returns the month before ym.
+func (ym YearMonth) Prev() YearMonth {
+	return ym - 1
+}
+
+// First returns the first date in ym.
+func (ym YearMonth) First() Date {
+	return New(ym.Year(), ym.Month(), 1)
+}
+
+// Last returns the last date in ym.
+func (ym YearMonth) Last() Date {
+	return ym.Next().First() - 1
+}
+
+// Days returns the number of days in ym.
+func (ym YearMonth) Days() int {
+	return gregorian.DaysIn(ym.Year(), ym.Month())
+}
+
+// Dates returns every date in ym, in order.
+func (ym YearMonth) Dates() []Date {
+	first := ym.First()
+	dates := make([]Date, ym.Days())
+	for i := range dates {
+		dates[i] = first + Date(i)
+	}
+	return dates
+}
+
+// String returns the year and month in ISO 8601 format, e.g. "2006-01". The year has at
+// least four digits; negative years have a '-' sign prefix (e.g. "-0987-06").
+func (ym YearMonth) String() string {
+	year := ym.Year()
+	if year < 0 {
+		return fmt.Sprintf("%05d-%02d", year, ym.Month())
+	}
+	return fmt.Sprintf("%04d-%02d", year, ym.Month())
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 Rick Beton. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYearMonth(t *testing.T) {
+	cases := []struct {
+		d          Date
+		str        string
+		next, prev string
+		days       int
+	}{
+		{d: New(2006, time.January, 2), str: "2006-01", next: "2006-02", prev: "2005-12", days: 31},
+		{d: New(2023, time.December, 31), str: "2023-12", next: "2024-01", prev: "2023-11", days: 31},
+		{d: New(2024, time.February, 10), str: "2024-02", next: "2024-03", prev: "2024-01", days: 29},
+		{d: New(2023, time.February, 28), str: "2023-02", next: "2023-03", prev: "2023-01", days: 28},
+		{d: New(0, time.January, 1), str: "0000-01", next: "0000-02", prev: "-0001-12", days: 31},
+		{d: New(-1, time.December, 1), str: "-0001-12", next: "0000-01", prev: "-0001-11", days: 31},
+	}
+	for i, c := range cases {
+		ym := c.d.YearMonth()
+		if ym.String() != c.str || ym.Next().String() != c.next || ym.Prev().String() != c.prev {
+			t.Errorf("%d: %v gave %v, %v, %v", i, c.d, ym, ym.Next(), ym.Prev())
+		}
+		if ym.Year() != c.d.Year() || ym.Month() != c.d.Month() {
+			t.Errorf("%d: %v gave %d, %v", i, c.d, ym.Year(), ym.Month())
+		}
+
+		dates := ym.Dates()
+		if len(dates) != c.days || ym.Days() != c.days {
+			t.Errorf("%d: %v has %d dates, want %d", i, ym, len(dates), c.days)
+		}
+		if dates[0] != ym.First() || dates[0].Day() != 1 || dates[len(dates)-1] != ym.Last() || ym.Last().YearMonth() != ym {
+			t.Errorf("%d: %v has dates %v to %v", i, ym, dates[0], dates[len(dates)-1])
+		}
+	}
+}
+
+func TestNewYearMonth(t *testing.T) {
+	if NewYearMonth(2023, 13) != NewYearMonth(2024, time.January) {
+		t.Errorf("got %v", NewYearMonth(2023, 13))
+	}
+	if NewYearMonth(2024, 0) != NewYearMonth(2023, time.December) {
+		t.Errorf("got %v", NewYearMonth(2024, 0))
+	}
+	if NewYearMonth(2023, time.December).Next() != NewYearMonth(2024, time.January) {
+		t.Errorf("December does not roll over")
+	}
+	if NewYearMonth(2023, time.March) >= NewYearMonth(2023, time.April) {
+		t.Errorf("not ordered")
+	}
+}